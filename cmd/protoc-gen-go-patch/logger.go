@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+)
+
+// newLogger returns a logger that writes text to stderr and, if logFile is not empty, JSON to logFile.
+// If logFile is empty, level sets the minimum level written to stderr, which defaults to warn.
+// Otherwise, level sets the minimum level written to logFile, which defaults to debug,
+// and warnings and errors are written to stderr.
+// If PROTO_PATCH_DEBUG_LOGGING is set, all levels are written to stderr.
+// The returned close func closes the log file, if any.
+func newLogger(logFile, level string) (logger *slog.Logger, close func() error, err error) {
+	stderrLevel, fileLevel := slog.LevelWarn, slog.LevelDebug
+	if level != "" {
+		var l slog.Level
+		if err := l.UnmarshalText([]byte(level)); err != nil {
+			return nil, nil, fmt.Errorf("invalid log_level: %w", err)
+		}
+		if logFile != "" {
+			fileLevel = l
+		} else {
+			stderrLevel = l
+		}
+	}
+	if os.Getenv("PROTO_PATCH_DEBUG_LOGGING") != "" {
+		stderrLevel = slog.LevelDebug
+	}
+
+	handlers := []slog.Handler{
+		slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+			Level:       stderrLevel,
+			ReplaceAttr: omitTime,
+		}),
+	}
+	close = func() error { return nil }
+
+	if logFile != "" {
+		f, err := os.OpenFile(logFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, nil, err
+		}
+		handlers = append(handlers, slog.NewJSONHandler(f, &slog.HandlerOptions{Level: fileLevel}))
+		close = f.Close
+	}
+
+	return slog.New(multiHandler(handlers)), close, nil
+}
+
+// omitTime removes the time from log records written to stderr, as protoc interleaves them with its own output.
+func omitTime(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) == 0 && a.Key == slog.TimeKey {
+		return slog.Attr{}
+	}
+	return a
+}
+
+// multiHandler is a slog.Handler that writes each record to every enabled handler.
+type multiHandler []slog.Handler
+
+func (h multiHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, hh := range h {
+		if hh.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h multiHandler) Handle(ctx context.Context, r slog.Record) error {
+	var errs []error
+	for _, hh := range h {
+		if hh.Enabled(ctx, r.Level) {
+			errs = append(errs, hh.Handle(ctx, r.Clone()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (h multiHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	hs := make(multiHandler, len(h))
+	for i, hh := range h {
+		hs[i] = hh.WithAttrs(attrs)
+	}
+	return hs
+}
+
+func (h multiHandler) WithGroup(name string) slog.Handler {
+	hs := make(multiHandler, len(h))
+	for i, hh := range h {
+		hs[i] = hh.WithGroup(name)
+	}
+	return hs
+}
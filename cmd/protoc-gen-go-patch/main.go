@@ -2,14 +2,15 @@ package main
 
 import (
 	"fmt"
-	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/alta/protopatch/patch"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/pluginpb"
 )
 
@@ -36,12 +37,49 @@ func run() error {
 	}
 
 	var plugin string
+	var patchOpts []patch.Option
+	var diffMode patch.DiffMode
+	var logFile, logLevel string
 
 	opts := protogen.Options{
 		ParamFunc: func(name, value string) error {
 			switch name {
 			case "plugin":
 				plugin = value
+			case "parallelism":
+				n, err := strconv.Atoi(value)
+				if err != nil {
+					return fmt.Errorf("invalid parallelism: %w", err)
+				}
+				patchOpts = append(patchOpts, patch.WithParallelism(n))
+			case "importer":
+				mode, err := patch.ParseImportMode(value)
+				if err != nil {
+					return err
+				}
+				patchOpts = append(patchOpts, patch.WithImportMode(mode))
+			case "diff":
+				mode, err := patch.ParseDiffMode(value)
+				if err != nil {
+					return err
+				}
+				diffMode = mode
+			case "strict":
+				strict, err := strconv.ParseBool(value)
+				if err != nil {
+					return fmt.Errorf("invalid strict: %w", err)
+				}
+				patchOpts = append(patchOpts, patch.WithStrict(strict))
+			case "manifest":
+				manifest, err := strconv.ParseBool(value)
+				if err != nil {
+					return fmt.Errorf("invalid manifest: %w", err)
+				}
+				patchOpts = append(patchOpts, patch.WithManifest(manifest))
+			case "log_file":
+				logFile = value
+			case "log_level":
+				logLevel = value
 			}
 			return nil // Ignore unknown params.
 		},
@@ -57,31 +95,50 @@ func run() error {
 		return fmt.Errorf("no protoc plugin specified; use 'protoc --%s_out=plugin=$PLUGIN:...'", s)
 	}
 
-	if os.Getenv("PROTO_PATCH_DEBUG_LOGGING") == "" {
-		log.SetOutput(ioutil.Discard)
+	logger, closeLog, err := newLogger(logFile, logLevel)
+	if err != nil {
+		return err
 	}
+	defer closeLog()
+	patchOpts = append(patchOpts, patch.WithLogger(logger))
 
 	// Strip our custom param(s).
 	patch.StripParam(gen.Request, "plugin")
+	patch.StripParam(gen.Request, "parallelism")
+	patch.StripParam(gen.Request, "importer")
+	patch.StripParam(gen.Request, "diff")
+	patch.StripParam(gen.Request, "log_file")
+	patch.StripParam(gen.Request, "log_level")
+	patch.StripParam(gen.Request, "strict")
+	patch.StripParam(gen.Request, "manifest")
 
 	// Run the specified plugin and unmarshal the CodeGeneratorResponse.
-	res, err := patch.RunPlugin(plugin, gen.Request, nil)
+	res, err := patch.RunPluginWithLogger(plugin, gen.Request, nil, logger)
 	if err != nil {
 		return err
 	}
 
 	// Initialize a Patcher and scan source proto files.
-	patcher, err := patch.NewPatcher(gen)
+	patcher, err := patch.NewPatcher(gen, patchOpts...)
 	if err != nil {
 		return err
 	}
 
+	// Keep the unpatched CodeGeneratorResponse to diff against.
+	original := proto.Clone(res).(*pluginpb.CodeGeneratorResponse)
+
 	// Patch the CodeGeneratorResponse.
 	err = patcher.Patch(res)
 	if err != nil {
 		return err
 	}
 
+	// Add diffs of the patched files, if requested.
+	err = patch.AddDiffs(original, res, diffMode)
+	if err != nil {
+		return err
+	}
+
 	supportedFeatures := uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 	res.SupportedFeatures = &supportedFeatures
 
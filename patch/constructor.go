@@ -0,0 +1,178 @@
+package patch
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/alta/protopatch/patch/ident"
+)
+
+// generateConstructors generates a constructor with functional options for each message in f
+// with the constructor option. Field names and types are read from the patched Go file gf.
+func (p *Patcher) generateConstructors(b *bytes.Buffer, f *protogen.File, gf *ast.File) {
+	walkMessages(f.Messages, func(m *protogen.Message) {
+		if !m.Desc.IsMapEntry() && messageOptions(m).GetConstructor() {
+			p.generateConstructor(b, m, gf)
+		}
+	})
+}
+
+func (p *Patcher) generateConstructor(b *bytes.Buffer, m *protogen.Message, gf *ast.File) {
+	name := p.nameFor(m.GoIdent)
+	st := findStructType(gf, name)
+	if st == nil {
+		p.warn("unable to find struct type for constructor", "message", m.Desc.FullName(), "type", name)
+		return
+	}
+	optName := name + "Option"
+	if conflict := p.declare(m.GoIdent.GoImportPath, "New"+name, optName); conflict != "" {
+		p.warn("constructor name conflicts with an existing name", "message", m.Desc.FullName(), "name", conflict)
+		return
+	}
+
+	fmt.Fprintf(b, "// %s is an option for New%s.\n", optName, name)
+	fmt.Fprintf(b, "type %s func(*%s)\n\n", optName, name)
+	fmt.Fprintf(b, "// New%s returns a new %s with opts applied.\n", name, name)
+	fmt.Fprintf(b, "func New%s(opts ...%s) *%s {\n", name, optName, name)
+	fmt.Fprintf(b, "\tx := &%s{}\n\tfor _, opt := range opts {\n\t\topt(x)\n\t}\n\treturn x\n}\n\n", name)
+
+	for _, f := range m.Fields {
+		a := p.fieldAssignment(m, f, gf, st)
+		if a == nil {
+			continue
+		}
+		if conflict := p.declare(m.GoIdent.GoImportPath, "With"+name+a.name); conflict != "" {
+			p.warn("constructor option name conflicts with an existing name", "field", f.Desc.FullName(), "name", conflict)
+			continue
+		}
+		fmt.Fprintf(b, "// With%s%s sets the %s field of a %s.\n", name, a.name, a.name, name)
+		fmt.Fprintf(b, "func With%s%s(v %s) %s {\n", name, a.name, a.typ, optName)
+		fmt.Fprintf(b, "\treturn func(x *%s) {\n\t\t%s\n\t}\n}\n\n", name, a.stmt("x", "v"))
+	}
+}
+
+// fieldAssignment describes how to assign a value to the Go struct field for a message field.
+type fieldAssignment struct {
+	name    string // Exported Go name of the field, used in method and option names
+	typ     string // Go type of the value
+	field   string // Go struct field to assign
+	pointer bool   // Assign a pointer to the value, for optional scalar fields
+	wrapper string // Oneof wrapper type, if any
+	wrapped string // Field in the oneof wrapper type
+}
+
+// stmt returns a Go statement that assigns v to the field of x.
+func (a *fieldAssignment) stmt(x, v string) string {
+	switch {
+	case a.wrapper != "":
+		return fmt.Sprintf("%s.%s = &%s{%s: %s}", x, a.field, a.wrapper, a.wrapped, v)
+	case a.pointer:
+		return fmt.Sprintf("%s.%s = &%s", x, a.field, v)
+	default:
+		return fmt.Sprintf("%s.%s = %s", x, a.field, v)
+	}
+}
+
+// fieldAssignment returns a fieldAssignment for message field f, with types read from the patched Go file gf,
+// or nil if the field cannot be found.
+func (p *Patcher) fieldAssignment(m *protogen.Message, f *protogen.Field, gf *ast.File, st *ast.StructType) *fieldAssignment {
+	if f.Oneof != nil && !f.Desc.HasOptionalKeyword() {
+		wrapper := p.nameFor(f.GoIdent)
+		wrapped := p.nameFor(ident.WithChild(f.GoIdent, f.GoName))
+		typ := findFieldType(findStructType(gf, wrapper), wrapped)
+		if typ == nil {
+			p.warn("unable to find oneof wrapper field", "field", f.Desc.FullName(), "type", wrapper)
+			return nil
+		}
+		return &fieldAssignment{
+			name:    wrapped,
+			typ:     p.nodeToString(typ),
+			field:   p.nameFor(ident.WithChild(m.GoIdent, f.Oneof.GoName)),
+			wrapper: wrapper,
+			wrapped: wrapped,
+		}
+	}
+
+	id := ident.WithChild(m.GoIdent, f.GoName)
+	field := p.nameFor(id)
+	typ := findFieldType(st, field)
+	if typ == nil {
+		p.warn("unable to find struct field", "field", f.Desc.FullName(), "name", field)
+		return nil
+	}
+	// Fields unexported by the private option are named after their exported name, like their getters.
+	name := field
+	if exported, ok := p.exportedNames[id]; ok {
+		name = exported
+	}
+	a := &fieldAssignment{
+		name:  name,
+		typ:   p.nodeToString(typ),
+		field: field,
+	}
+	if star, ok := typ.(*ast.StarExpr); ok && f.Message == nil && f.Desc.HasPresence() {
+		a.typ = p.nodeToString(star.X)
+		a.pointer = true
+	}
+	return a
+}
+
+// findStructType returns the struct type declared with name in f, or nil if not found.
+func findStructType(f *ast.File, name string) *ast.StructType {
+	if f == nil {
+		return nil
+	}
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok || ts.Name.Name != name {
+				continue
+			}
+			st, _ := ts.Type.(*ast.StructType)
+			return st
+		}
+	}
+	return nil
+}
+
+// findFieldType returns the type of the field with name in st, or nil if not found.
+// Embedded fields are found by the name of their type.
+func findFieldType(st *ast.StructType, name string) ast.Expr {
+	if field := findField(st, name); field != nil {
+		return field.Type
+	}
+	return nil
+}
+
+// findField returns the field with name in st, or nil if not found.
+// Embedded fields are found by the name of their type.
+func findField(st *ast.StructType, name string) *ast.Field {
+	if st == nil {
+		return nil
+	}
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 {
+			typ := field.Type
+			if star, ok := typ.(*ast.StarExpr); ok {
+				typ = star.X
+			}
+			if id, ok := typ.(*ast.Ident); ok && id.Name == name {
+				return field
+			}
+			continue
+		}
+		for _, n := range field.Names {
+			if n.Name == name {
+				return field
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/alta/protopatch/patch/gopb"
+	"github.com/alta/protopatch/tests/message"
+)
+
+func TestConstructorConflicts(t *testing.T) {
+	constructor := func() *descriptorpb.MessageOptions {
+		opts := &descriptorpb.MessageOptions{}
+		proto.SetExtension(opts, gopb.E_Message, &gopb.Options{Constructor: proto.Bool(true)})
+		return opts
+	}
+	int64Field := func(name string, number int32) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum(),
+		}
+	}
+	tests := []struct {
+		name   string
+		mutate func(fd *descriptorpb.FileDescriptorProto)
+		want   string
+	}{
+		{
+			name: "option type",
+			mutate: func(fd *descriptorpb.FileDescriptorProto) {
+				fd.MessageType = append(fd.MessageType, &descriptorpb.DescriptorProto{Name: proto.String("AccountOption")})
+			},
+			want: "protopatch: constructor name conflicts with an existing name message=tests.message.Account name=AccountOption",
+		},
+		{
+			name: "option",
+			mutate: func(fd *descriptorpb.FileDescriptorProto) {
+				fd.MessageType[0].Field = append(fd.MessageType[0].Field, int64Field("name_id", 9))
+				fd.MessageType = append(fd.MessageType, &descriptorpb.DescriptorProto{
+					Name:    proto.String("AccountName"),
+					Field:   []*descriptorpb.FieldDescriptorProto{int64Field("id", 1)},
+					Options: constructor(),
+				})
+			},
+			want: "protopatch: constructor option name conflicts with an existing name field=tests.message.AccountName.id name=WithAccountNameId",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testRequest("paths=import", message.File_tests_message_message_constructors_proto)
+			res := testPatch(t, req, WithStrict(true))
+			assert.Nil(t, res.Error)
+
+			for _, fd := range req.ProtoFile {
+				if fd.GetName() == message.File_tests_message_message_constructors_proto.Path() {
+					tt.mutate(fd)
+				}
+			}
+			res = testPatch(t, req, WithStrict(true))
+			if assert.NotNil(t, res.Error) {
+				assert.Equal(t, tt.want, res.GetError())
+			}
+		})
+	}
+}
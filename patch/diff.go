@@ -0,0 +1,92 @@
+package patch
+
+import (
+	"fmt"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// DiffMode determines whether unified diffs of patched files are added to a CodeGeneratorResponse.
+type DiffMode int
+
+const (
+	// DiffNone adds no diffs.
+	DiffNone DiffMode = iota
+
+	// DiffAlongside adds a diff alongside each patched file.
+	DiffAlongside
+
+	// DiffOnly replaces the generated files with their diffs.
+	// Files added by patching, such as manifests, are kept.
+	DiffOnly
+)
+
+// ParseDiffMode parses the value of the diff param into a DiffMode.
+// Valid values are "false", "true", and "only".
+func ParseDiffMode(s string) (DiffMode, error) {
+	switch s {
+	case "", "false":
+		return DiffNone, nil
+	case "true":
+		return DiffAlongside, nil
+	case "only":
+		return DiffOnly, nil
+	}
+	return DiffNone, fmt.Errorf("unknown diff mode: %q", s)
+}
+
+// AddDiffs adds a unified diff between each file in original and the corresponding file in res to res,
+// named with a .diff suffix. Files that were not changed by patching have no diff.
+// Files added by patching, with no corresponding file in original, have no diff.
+// If mode is DiffOnly, the files in res that have a corresponding file in original are replaced with their diffs.
+func AddDiffs(original, res *pluginpb.CodeGeneratorResponse, mode DiffMode) error {
+	if mode == DiffNone {
+		return nil
+	}
+
+	before := make(map[string]string, len(original.File))
+	for _, rf := range original.File {
+		if rf.Name != nil && rf.InsertionPoint == nil {
+			before[rf.GetName()] = rf.GetContent()
+		}
+	}
+
+	var added, diffs []*pluginpb.CodeGeneratorResponse_File
+	for _, rf := range res.File {
+		if rf.Name == nil || rf.InsertionPoint != nil {
+			continue
+		}
+		a, ok := before[rf.GetName()]
+		if !ok {
+			added = append(added, rf)
+			continue
+		}
+		b := rf.GetContent()
+		if a == b {
+			continue
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(a),
+			B:        difflib.SplitLines(b),
+			FromFile: "a/" + rf.GetName(),
+			ToFile:   "b/" + rf.GetName(),
+			Context:  3,
+		})
+		if err != nil {
+			return err
+		}
+		name := rf.GetName() + ".diff"
+		diffs = append(diffs, &pluginpb.CodeGeneratorResponse_File{
+			Name:    &name,
+			Content: &diff,
+		})
+	}
+
+	if mode == DiffOnly {
+		res.File = append(added, diffs...)
+	} else {
+		res.File = append(res.File, diffs...)
+	}
+	return nil
+}
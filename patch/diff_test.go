@@ -0,0 +1,91 @@
+package patch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/alta/protopatch/tests/message"
+)
+
+func TestAddDiffs(t *testing.T) {
+	req := testRequest("paths=import", message.File_tests_message_message_renames_proto)
+	original := testGenerate(t, req)
+	const name = "github.com/alta/protopatch/tests/message/message_renames.pb.go"
+
+	tests := []struct {
+		mode  DiffMode
+		files []string
+	}{
+		{DiffNone, []string{name}},
+		{DiffAlongside, []string{name, name + ".diff"}},
+		{DiffOnly, []string{name + ".diff"}},
+	}
+	for _, tt := range tests {
+		res := testPatch(t, req)
+		if err := AddDiffs(original, res, tt.mode); err != nil {
+			t.Fatal(err)
+		}
+		var files []string
+		for _, rf := range res.File {
+			files = append(files, rf.GetName())
+		}
+		assert.Equal(t, tt.files, files, tt.mode)
+		for _, rf := range res.File {
+			if !strings.HasSuffix(rf.GetName(), ".diff") {
+				continue
+			}
+			diff := rf.GetContent()
+			assert.True(t, strings.HasPrefix(diff, "--- a/"+name+"\n+++ b/"+name+"\n"), diff)
+			assert.Contains(t, diff, "\n-type Francis struct {\n")
+			assert.Contains(t, diff, "\n+type Frank struct {\n")
+		}
+	}
+}
+
+func TestAddDiffsManifest(t *testing.T) {
+	req := testRequest("paths=import", message.File_tests_message_message_renames_proto)
+	original := testGenerate(t, req)
+	const name = "github.com/alta/protopatch/tests/message/message_renames"
+
+	res := testPatch(t, req, WithManifest(true))
+	if err := AddDiffs(original, res, DiffOnly); err != nil {
+		t.Fatal(err)
+	}
+	var files []string
+	for _, rf := range res.File {
+		files = append(files, rf.GetName())
+	}
+	assert.ElementsMatch(t, []string{name + ".protopatch.json", name + ".pb.go.diff"}, files)
+	for _, rf := range res.File {
+		if strings.HasSuffix(rf.GetName(), ".protopatch.json") {
+			assert.Contains(t, rf.GetContent(), `"Frank"`)
+		}
+	}
+}
+
+func TestAddDiffsUnchanged(t *testing.T) {
+	original := &pluginpb.CodeGeneratorResponse{
+		File: []*pluginpb.CodeGeneratorResponse_File{
+			{Name: proto.String("a.pb.go"), Content: proto.String("package a\n")},
+		},
+	}
+	res := proto.Clone(original).(*pluginpb.CodeGeneratorResponse)
+	if err := AddDiffs(original, res, DiffOnly); err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, res.File)
+}
+
+func TestParseDiffMode(t *testing.T) {
+	for s, want := range map[string]DiffMode{"": DiffNone, "false": DiffNone, "true": DiffAlongside, "only": DiffOnly} {
+		got, err := ParseDiffMode(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, want, got, s)
+	}
+	_, err := ParseDiffMode("yes")
+	assert.Error(t, err)
+}
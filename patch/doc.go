@@ -0,0 +1,212 @@
+package patch
+
+import (
+	"go/ast"
+	"go/token"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/alta/protopatch/patch/gopb"
+	"github.com/alta/protopatch/patch/ident"
+)
+
+// deprecationNotice is the prefix of the deprecation notice that protoc-gen-go generates for deprecated elements.
+const deprecationNotice = "// Deprecated: Marked as deprecated in "
+
+// patchDocs patches the Go doc comments of the messages, fields, enums, and enum values in f
+// with the doc, doc_append, and deprecated_message options, in Go file gf.
+func (p *Patcher) patchDocs(f *protogen.File, gf *ast.File) {
+	walkMessages(f.Messages, func(m *protogen.Message) {
+		if m.Desc.IsMapEntry() {
+			return
+		}
+		if gd := findTypeDecl(gf, p.nameFor(m.GoIdent)); gd != nil {
+			p.patchDoc(gf, &gd.Doc, gd.Pos(), messageOptions(m), m.Desc)
+		}
+		st := findStructType(gf, p.nameFor(m.GoIdent))
+		for _, f := range m.Fields {
+			opts := fieldOptions(f)
+			var field *ast.Field
+			if f.Oneof != nil && !f.Desc.HasOptionalKeyword() {
+				field = findField(findStructType(gf, p.nameFor(f.GoIdent)), p.nameFor(ident.WithChild(f.GoIdent, f.GoName)))
+			} else {
+				field = findField(st, p.nameFor(ident.WithChild(m.GoIdent, f.GoName)))
+			}
+			if field != nil {
+				p.patchDoc(gf, &field.Doc, field.Pos(), opts, f.Desc)
+			}
+			// protoc-gen-go also marks the getter of a deprecated field as deprecated.
+			if isDeprecated(f.Desc) {
+				getter := findMethod(gf, p.nameFor(m.GoIdent), p.nameFor(ident.WithChild(m.GoIdent, "Get"+f.GoName)))
+				if getter != nil {
+					p.patchDoc(gf, &getter.Doc, getter.Pos(), &gopb.Options{DeprecatedMessage: opts.DeprecatedMessage}, f.Desc)
+				}
+			}
+		}
+	})
+	walkEnums(f, func(e *protogen.Enum) {
+		if gd := findTypeDecl(gf, p.nameFor(e.GoIdent)); gd != nil {
+			p.patchDoc(gf, &gd.Doc, gd.Pos(), enumOptions(e), e.Desc)
+		}
+		for _, v := range e.Values {
+			if vs := findValueSpec(gf, p.nameFor(v.GoIdent)); vs != nil {
+				p.patchDoc(gf, &vs.Doc, vs.Pos(), valueOptions(v), v.Desc)
+			}
+		}
+	})
+}
+
+// patchDoc patches the doc comment *doc of the declaration at pos in gf with the doc, doc_append,
+// and deprecated_message options in opts, for the element described by d.
+// The deprecation notice generated by protoc-gen-go is kept, unless replaced by the deprecated_message option.
+func (p *Patcher) patchDoc(gf *ast.File, doc **ast.CommentGroup, pos token.Pos, opts *gopb.Options, d protoreflect.Descriptor) {
+	if opts == nil || (opts.Doc == nil && opts.GetDocAppend() == "" && opts.GetDeprecatedMessage() == "") {
+		return
+	}
+
+	var body, notice []string
+	if *doc != nil {
+		for _, c := range (*doc).List {
+			if notice != nil || strings.HasPrefix(c.Text, deprecationNotice) {
+				notice = append(notice, c.Text)
+			} else {
+				body = append(body, c.Text)
+			}
+		}
+		if len(body) > 0 && body[len(body)-1] == "//" {
+			body = body[:len(body)-1]
+		}
+	}
+	if opts.Doc != nil {
+		body = commentLines(opts.GetDoc())
+	}
+	if s := opts.GetDocAppend(); s != "" {
+		if len(body) > 0 {
+			body = append(body, "//")
+		}
+		body = append(body, commentLines(s)...)
+	}
+	if s := opts.GetDeprecatedMessage(); s != "" {
+		if isDeprecated(d) {
+			notice = commentLines("Deprecated: " + s)
+		} else {
+			p.warn("deprecated_message declared for element that is not deprecated", descriptorKind(d), d.FullName())
+		}
+	}
+	lines := body
+	if len(notice) > 0 {
+		if len(lines) > 0 {
+			lines = append(lines, "//")
+		}
+		lines = append(lines, notice...)
+	}
+
+	if len(lines) == 0 {
+		if *doc != nil {
+			removeCommentGroup(gf, *doc)
+			*doc = nil
+		}
+		return
+	}
+	// Comments are positioned on the last lines of the existing comments, or before the declaration,
+	// so the printer keeps them on consecutive lines directly above the declaration.
+	list := make([]*ast.Comment, len(lines))
+	for i, text := range lines {
+		slash := pos - 1
+		if *doc != nil {
+			old := (*doc).List
+			slash = old[min(i+max(len(old)-len(lines), 0), len(old)-1)].Slash
+		}
+		list[i] = &ast.Comment{Slash: slash, Text: text}
+	}
+	if *doc != nil {
+		(*doc).List = list
+		return
+	}
+	*doc = &ast.CommentGroup{List: list}
+	gf.Comments = append(gf.Comments, *doc)
+	sort.SliceStable(gf.Comments, func(i, j int) bool { return gf.Comments[i].Pos() < gf.Comments[j].Pos() })
+}
+
+// commentLines returns the lines of s as Go line comments, or nil if s is empty.
+func commentLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	var lines []string
+	for _, line := range strings.Split(strings.TrimRight(s, "\n"), "\n") {
+		if line = strings.TrimRight(line, " \t"); line == "" {
+			lines = append(lines, "//")
+		} else {
+			lines = append(lines, "// "+line)
+		}
+	}
+	return lines
+}
+
+// removeCommentGroup removes comment group cg from the comments in f.
+func removeCommentGroup(f *ast.File, cg *ast.CommentGroup) {
+	for i, c := range f.Comments {
+		if c == cg {
+			f.Comments = append(f.Comments[:i], f.Comments[i+1:]...)
+			return
+		}
+	}
+}
+
+// isDeprecated reports whether the element described by d has the deprecated option.
+func isDeprecated(d protoreflect.Descriptor) bool {
+	opts, ok := d.Options().(interface{ GetDeprecated() bool })
+	return ok && opts.GetDeprecated()
+}
+
+// descriptorKind returns the kind of element described by d, for logging.
+func descriptorKind(d protoreflect.Descriptor) string {
+	switch d.(type) {
+	case protoreflect.MessageDescriptor:
+		return "message"
+	case protoreflect.FieldDescriptor:
+		return "field"
+	case protoreflect.EnumDescriptor:
+		return "enum"
+	case protoreflect.EnumValueDescriptor:
+		return "value"
+	default:
+		return "descriptor"
+	}
+}
+
+// findTypeDecl returns the declaration of the type with name in f, or nil if not found.
+func findTypeDecl(f *ast.File, name string) *ast.GenDecl {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == name {
+				return gd
+			}
+		}
+	}
+	return nil
+}
+
+// findValueSpec returns the declaration of the const with name in f, or nil if not found.
+func findValueSpec(f *ast.File, name string) *ast.ValueSpec {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			if vs, ok := spec.(*ast.ValueSpec); ok && len(vs.Names) == 1 && vs.Names[0].Name == name {
+				return vs
+			}
+		}
+	}
+	return nil
+}
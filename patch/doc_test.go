@@ -0,0 +1,30 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alta/protopatch/tests/message"
+)
+
+func TestDeprecatedMessageWithoutDeprecation(t *testing.T) {
+	req := testRequest("paths=import", message.File_tests_message_message_docs_proto)
+	res := testPatch(t, req, WithStrict(true))
+	assert.Nil(t, res.Error)
+
+	for _, fd := range req.ProtoFile {
+		if fd.GetName() != message.File_tests_message_message_docs_proto.Path() {
+			continue
+		}
+		for _, f := range fd.MessageType[0].Field {
+			if f.GetName() == "summary" {
+				f.Options.Deprecated = nil
+			}
+		}
+	}
+	res = testPatch(t, req, WithStrict(true))
+	if assert.NotNil(t, res.Error) {
+		assert.Equal(t, "protopatch: deprecated_message declared for element that is not deprecated field=tests.message.Document.summary", res.GetError())
+	}
+}
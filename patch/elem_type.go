@@ -0,0 +1,210 @@
+package patch
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+)
+
+// elementType specifies the Go types of the keys and values of a map field, or the elements of a slice field.
+// An empty key or elem keeps the generated type.
+type elementType struct {
+	key  string // Map key type
+	elem string // Map value or slice element type
+}
+
+// patchElemTypeDef casts the keys and elements of the map or slice type of a struct field or getter declaration.
+func (p *Patcher) patchElemTypeDef(id *ast.Ident, obj types.Object, typ elementType) {
+	field := p.typeDeclField(id, obj)
+	if field == nil {
+		return
+	}
+	switch t := field.Type.(type) {
+	case *ast.MapType:
+		if typ.key != "" {
+			t.Key = &ast.Ident{Name: typ.key}
+		}
+		if typ.elem != "" {
+			t.Value = &ast.Ident{Name: typ.elem}
+		}
+	case *ast.ArrayType:
+		if typ.elem != "" {
+			t.Elt = &ast.Ident{Name: typ.elem}
+		}
+	default:
+		p.warn("unsupported element type", "expr", fmt.Sprintf("%T", field.Type), "object", obj)
+	}
+}
+
+// typeDeclField returns the struct field declared by id, or the result of the getter method declared by id.
+func (p *Patcher) typeDeclField(id *ast.Ident, obj types.Object) *ast.Field {
+	if id.Obj != nil && id.Obj.Decl != nil {
+		v, ok := id.Obj.Decl.(*ast.Field)
+		if !ok {
+			p.warn("element type declared for non-field object", "object", obj)
+			return nil
+		}
+		return v
+	}
+	if _, ok := obj.Type().(*types.Signature); !ok {
+		return nil
+	}
+	n, ok := p.findParentNode(id).(*ast.FuncDecl)
+	if !ok || n.Type.Results == nil || len(n.Type.Results.List) != 1 {
+		p.warn("unexpected getter declaration", "object", obj)
+		return nil
+	}
+	return n.Type.Results.List[0]
+}
+
+// patchElemTypeUsage converts values assigned to or read from a struct field with element types
+// outside of its getter method. Values are copied, as maps and slices with different key or element types
+// cannot be converted directly.
+func (p *Patcher) patchElemTypeUsage(id *ast.Ident, obj types.Object, typ elementType) {
+	for node := p.findParentNode(id); node != nil; node = p.findParentNode(node) {
+		if fn, ok := node.(*ast.FuncDecl); ok {
+			if _, ok := p.fieldElemTypes[p.info.Defs[fn.Name]]; ok && fn.Recv != nil {
+				return
+			}
+			break
+		}
+	}
+
+	t := obj.Type()
+	if sig, ok := t.(*types.Signature); ok {
+		if sig.Results().Len() != 1 {
+			return
+		}
+		t = sig.Results().At(0).Type()
+	}
+	to, from := p.elemConversions(t, typ, relativeQualifier(obj.Pkg()))
+	if to == "" {
+		return
+	}
+	convert := func(conv string, expr ast.Expr) ast.Expr {
+		fn, err := parser.ParseExpr(conv)
+		if err != nil {
+			p.warn("unable to parse element type conversion", "object", obj, "error", err)
+			return expr
+		}
+		clearPositions(fn)
+		call := &ast.CallExpr{
+			Fun:  fn,
+			Args: []ast.Expr{expr},
+		}
+		p.replaceParent(expr, call)
+		return call
+	}
+
+	expr := p.findParentNode(id)
+	if kv, ok := expr.(*ast.KeyValueExpr); ok {
+		if kv.Key == id {
+			kv.Value = convert(to, kv.Value)
+		}
+		return
+	}
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	if call, ok := p.findParentNode(sel).(*ast.CallExpr); ok && call.Fun == sel {
+		expr = call // Getter call
+	} else {
+		expr = sel
+	}
+	switch parent := p.findParentNode(expr).(type) {
+	case *ast.AssignStmt:
+		if len(parent.Lhs) != len(parent.Rhs) {
+			return
+		}
+		for i := range parent.Lhs {
+			if parent.Lhs[i] == expr {
+				parent.Rhs[i] = convert(to, parent.Rhs[i])
+				return
+			}
+		}
+		for i := range parent.Rhs {
+			if parent.Rhs[i] == expr && parent.Tok != token.DEFINE {
+				parent.Rhs[i] = convert(from, parent.Rhs[i])
+				return
+			}
+		}
+	case *ast.CallExpr:
+		for i := range parent.Args {
+			if parent.Args[i] == expr {
+				parent.Args[i] = convert(from, parent.Args[i])
+				return
+			}
+		}
+	}
+}
+
+// elemConversions returns the source of function literals that convert map or slice type t to and from element types typ.
+func (p *Patcher) elemConversions(t types.Type, typ elementType, qualifier types.Qualifier) (to, from string) {
+	str := func(t types.Type) string {
+		return types.TypeString(t, qualifier)
+	}
+	or := func(s, t string) string {
+		if s != "" {
+			return s
+		}
+		return t
+	}
+	conv := func(typeName, v string) string {
+		if typeName == "" {
+			return v
+		}
+		return typeName + "(" + v + ")"
+	}
+	switch t := t.(type) {
+	case *types.Map:
+		key, elem := str(t.Key()), str(t.Elem())
+		newKey, newElem := or(typ.key, key), or(typ.elem, elem)
+		mapConv := func(fromKey, fromElem, toKey, toElem, convKey, convElem string) string {
+			return fmt.Sprintf("func(m map[%s]%s) map[%s]%s { if m == nil { return nil }; r := make(map[%s]%s, len(m)); for k, v := range m { r[%s] = %s }; return r }",
+				fromKey, fromElem, toKey, toElem, toKey, toElem, conv(convKey, "k"), conv(convElem, "v"))
+		}
+		to = mapConv(key, elem, newKey, newElem, typ.key, typ.elem)
+		from = mapConv(newKey, newElem, key, elem, castBack(typ.key, key), castBack(typ.elem, elem))
+	case *types.Slice:
+		elem := str(t.Elem())
+		newElem := or(typ.elem, elem)
+		sliceConv := func(fromElem, toElem, convElem string) string {
+			return fmt.Sprintf("func(s []%s) []%s { if s == nil { return nil }; r := make([]%s, len(s)); for i, v := range s { r[i] = %s }; return r }",
+				fromElem, toElem, toElem, conv(convElem, "v"))
+		}
+		to = sliceConv(elem, newElem, typ.elem)
+		from = sliceConv(newElem, elem, castBack(typ.elem, elem))
+	}
+	return to, from
+}
+
+// clearPositions sets the positions in the syntax tree n to token.NoPos,
+// so nodes parsed separately can be inserted in a file without affecting the placement of its comments.
+func clearPositions(n ast.Node) {
+	pos := reflect.TypeOf(token.NoPos)
+	ast.Inspect(n, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		v := reflect.ValueOf(n).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); f.Type() == pos && f.CanSet() {
+				f.SetInt(int64(token.NoPos))
+			}
+		}
+		return true
+	})
+}
+
+// castBack returns t if cast is not empty, or an empty string otherwise,
+// for converting a value of the cast type back to its original type t.
+func castBack(cast, t string) string {
+	if cast == "" {
+		return ""
+	}
+	return t
+}
@@ -0,0 +1,251 @@
+package patch
+
+import (
+	"go/ast"
+	"go/format"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func TestMapCastType(t *testing.T) {
+	const (
+		srcDef = `package foo
+
+type UserID string
+
+type Score int64
+
+type Message struct {
+	Content map[string]int64
+}
+
+func (m *Message) GetContent() map[string]int64 {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func count(m map[string]int64) int {
+	return len(m)
+}
+`
+		wantDef = `package foo
+
+type UserID string
+
+type Score int64
+
+type Message struct {
+	Content map[UserID]Score
+}
+
+func (m *Message) GetContent() map[UserID]Score {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func count(m map[string]int64) int {
+	return len(m)
+}
+`
+	)
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "cast definition",
+			src:  srcDef,
+			want: wantDef,
+		},
+		{
+			name: "cast field initialization",
+			src: srcDef + `
+func useContent() {
+	m := map[string]int64{"a": 1}
+	_ = &Message{Content: m}
+}
+`,
+			want: wantDef + `
+func useContent() {
+	m := map[string]int64{"a": 1}
+	_ = &Message{Content: func(m map[string]int64) map[UserID]Score {
+		if m == nil {
+			return nil
+		}
+		r := make(map[UserID]Score, len(m))
+		for k, v := range m {
+			r[UserID(k)] = Score(v)
+		}
+		return r
+	}(m)}
+}
+`,
+		},
+		{
+			name: "cast field usage",
+			src: srcDef + `
+func useContent(msg *Message) {
+	var m map[string]int64
+	msg.Content = m
+	m = msg.GetContent()
+	count(msg.Content)
+}
+`,
+			want: wantDef + `
+func useContent(msg *Message) {
+	var m map[string]int64
+	msg.Content = func(m map[string]int64) map[UserID]Score {
+		if m == nil {
+			return nil
+		}
+		r := make(map[UserID]Score, len(m))
+		for k, v := range m {
+			r[UserID(k)] = Score(v)
+		}
+		return r
+	}(m)
+	m = func(m map[UserID]Score) map[string]int64 {
+		if m == nil {
+			return nil
+		}
+		r := make(map[string]int64, len(m))
+		for k, v := range m {
+			r[string(k)] = int64(v)
+		}
+		return r
+	}(msg.GetContent())
+	count(func(m map[UserID]Score) map[string]int64 {
+		if m == nil {
+			return nil
+		}
+		r := make(map[string]int64, len(m))
+		for k, v := range m {
+			r[string(k)] = int64(v)
+		}
+		return r
+	}(msg.Content))
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, file, err := preparePatch(tt.src, func(p *Patcher, field, getter protogen.GoIdent) {
+				p.MapType(field, "UserID", "Score")
+				p.MapType(getter, "UserID", "Score")
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := p.patchGoFiles(); err != nil {
+				t.Fatal(err)
+			}
+			assertIdentifiers(t, file)
+			got, err := format.Source([]byte(p.nodeToString(file)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestSliceCastType(t *testing.T) {
+	const src = `package foo
+
+type UserID string
+
+type Message struct {
+	Content []string
+}
+
+func (m *Message) GetContent() []string {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func useContent(msg *Message) {
+	var s []string
+	msg.Content = s
+	s = msg.GetContent()
+}
+`
+	const want = `package foo
+
+type UserID string
+
+type Message struct {
+	Content []UserID
+}
+
+func (m *Message) GetContent() []UserID {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func useContent(msg *Message) {
+	var s []string
+	msg.Content = func(s []string) []UserID {
+		if s == nil {
+			return nil
+		}
+		r := make([]UserID, len(s))
+		for i, v := range s {
+			r[i] = UserID(v)
+		}
+		return r
+	}(s)
+	s = func(s []UserID) []string {
+		if s == nil {
+			return nil
+		}
+		r := make([]string, len(s))
+		for i, v := range s {
+			r[i] = string(v)
+		}
+		return r
+	}(msg.GetContent())
+}
+`
+	p, file, err := preparePatch(src, func(p *Patcher, field, getter protogen.GoIdent) {
+		p.ElemType(field, "UserID")
+		p.ElemType(getter, "UserID")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.patchGoFiles(); err != nil {
+		t.Fatal(err)
+	}
+	assertIdentifiers(t, file)
+	got, err := format.Source([]byte(p.nodeToString(file)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, want, string(got))
+}
+
+// assertIdentifiers asserts that every identifier in f is a valid Go identifier,
+// and not Go source inserted as an identifier.
+func assertIdentifiers(t *testing.T, f *ast.File) {
+	t.Helper()
+	ast.Inspect(f, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			assert.True(t, token.IsIdentifier(id.Name), "invalid identifier: %s", id.Name)
+		}
+		return true
+	})
+}
@@ -0,0 +1,405 @@
+package patch
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/alta/protopatch/patch/ident"
+)
+
+// walkEnums calls fn for each enum in f, including enums nested in messages.
+func walkEnums(f *protogen.File, fn func(*protogen.Enum)) {
+	for _, e := range f.Enums {
+		fn(e)
+	}
+	walkMessages(f.Messages, func(m *protogen.Message) {
+		for _, e := range m.Enums {
+			fn(e)
+		}
+	})
+}
+
+// generateEnums patches the enums in f with custom value strings and preferred aliases in Go file gf,
+// and generates methods for enums with the text_marshaler or helpers options.
+func (p *Patcher) generateEnums(b *bytes.Buffer, f *protogen.File, gf *ast.File) {
+	walkEnums(f, func(e *protogen.Enum) {
+		p.dedupeEnumValues(gf, e)
+		strs := p.enumStrings(e)
+		preferred := p.preferredValues(e)
+		if len(strs) > 0 || len(preferred) > 0 {
+			p.patchEnumMaps(gf, e, strs, preferred)
+			p.patchEnumStringer(gf, e)
+		}
+		if enumOptions(e).GetTextMarshaler() {
+			p.generateTextMarshaler(b, e, gf)
+		}
+		if enumOptions(e).GetHelpers() {
+			p.generateEnumHelpers(b, e, gf, preferred)
+		}
+	})
+}
+
+// checkEnumValueNames checks the patched Go names of the values of e for conflicts, e.g. from lint or name options.
+// Aliases of the same number may share a name, and are declared once by dedupeEnumValues.
+// A value whose name conflicts with a value of another number keeps its original name, with a warning.
+func (p *Patcher) checkEnumValueNames(e *protogen.Enum) {
+	seen := make(map[string]*protogen.EnumValue)
+	for _, v := range e.Values {
+		name := p.nameFor(v.GoIdent)
+		prev, ok := seen[name]
+		if ok && prev.Desc.Number() != v.Desc.Number() {
+			p.warn("enum value name conflicts with another value", "value", v.Desc.FullName(), "name", name, "conflict", prev.Desc.FullName())
+			delete(p.renames, v.GoIdent)
+			delete(p.valueRenames, v.GoIdent)
+			name = v.GoIdent.GoName
+			prev, ok = seen[name]
+		}
+		if !ok {
+			seen[name] = v
+		}
+	}
+}
+
+// dedupeEnumValues removes the const declarations of aliases in e that have the same patched Go name
+// as an earlier value of the same number.
+func (p *Patcher) dedupeEnumValues(gf *ast.File, e *protogen.Enum) {
+	seen := make(map[string]protoreflect.EnumNumber)
+	dupes := make(map[string]bool)
+	for _, v := range e.Values {
+		name := p.nameFor(v.GoIdent)
+		if n, ok := seen[name]; ok && n == v.Desc.Number() {
+			dupes[name] = true
+			p.log.Debug("dedupe enum value", "value", v.Desc.FullName(), "name", name)
+		}
+		seen[name] = v.Desc.Number()
+	}
+	if len(dupes) == 0 {
+		return
+	}
+	removed := make(map[*ast.CommentGroup]bool)
+	typeName := p.nameFor(e.GoIdent)
+	for _, decl := range gf.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+		declared := make(map[string]bool)
+		specs := gd.Specs[:0]
+		for _, spec := range gd.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if ok && len(vs.Names) == 1 && dupes[vs.Names[0].Name] && isIdent(vs.Type, typeName) {
+				if declared[vs.Names[0].Name] {
+					removed[vs.Doc] = true
+					removed[vs.Comment] = true
+					continue
+				}
+				declared[vs.Names[0].Name] = true
+			}
+			specs = append(specs, spec)
+		}
+		gd.Specs = specs
+	}
+	comments := gf.Comments[:0]
+	for _, c := range gf.Comments {
+		if !removed[c] {
+			comments = append(comments, c)
+		}
+	}
+	gf.Comments = comments
+}
+
+// isIdent reports whether expr is an identifier with name.
+func isIdent(expr ast.Expr, name string) bool {
+	id, ok := expr.(*ast.Ident)
+	return ok && id.Name == name
+}
+
+// preferredValues returns the values of e with the preferred option, keyed by number,
+// for numbers where the preferred value is not the first declared value.
+// Preferred values without aliases, or that share a number with another preferred value, are ignored with a warning.
+func (p *Patcher) preferredValues(e *protogen.Enum) map[protoreflect.EnumNumber]*protogen.EnumValue {
+	first := make(map[protoreflect.EnumNumber]*protogen.EnumValue)
+	count := make(map[protoreflect.EnumNumber]int)
+	for _, v := range e.Values {
+		if first[v.Desc.Number()] == nil {
+			first[v.Desc.Number()] = v
+		}
+		count[v.Desc.Number()]++
+	}
+	preferred := make(map[protoreflect.EnumNumber]*protogen.EnumValue)
+	marked := make(map[protoreflect.EnumNumber]bool)
+	for _, v := range e.Values {
+		if !valueOptions(v).GetPreferred() {
+			continue
+		}
+		n := v.Desc.Number()
+		switch {
+		case count[n] == 1:
+			p.warn("preferred enum value has no aliases", "value", v.Desc.FullName())
+		case marked[n]:
+			p.warn("enum value number has more than one preferred value", "value", v.Desc.FullName(), "number", n)
+		default:
+			marked[n] = true
+			if v != first[n] {
+				preferred[n] = v
+			}
+		}
+	}
+	return preferred
+}
+
+// enumStrings returns the custom strings for the values of e, keyed by proto value name.
+// Strings that conflict with the name or string of another value are ignored with a warning.
+func (p *Patcher) enumStrings(e *protogen.Enum) map[string]string {
+	strs := make(map[string]string)
+	seen := make(map[string]protoreflect.Name)
+	for _, v := range e.Values {
+		seen[string(v.Desc.Name())] = v.Desc.Name()
+	}
+	for _, v := range e.Values {
+		s := valueOptions(v).GetString_()
+		if s == "" {
+			continue
+		}
+		if name, ok := seen[s]; ok && name != v.Desc.Name() {
+			p.warn("enum value string conflicts with another value", "value", v.Desc.FullName(), "string", s)
+			continue
+		}
+		seen[s] = v.Desc.Name()
+		strs[string(v.Desc.Name())] = s
+	}
+	return strs
+}
+
+// patchEnumMaps replaces proto value names with custom strings in the <Enum>_name and <Enum>_value maps for e,
+// and replaces the names of aliased numbers in the <Enum>_name map with the names of preferred values.
+func (p *Patcher) patchEnumMaps(gf *ast.File, e *protogen.Enum, strs map[string]string, preferred map[protoreflect.EnumNumber]*protogen.EnumValue) {
+	replace := func(lit *ast.BasicLit) {
+		if name, err := strconv.Unquote(lit.Value); err == nil && strs[name] != "" {
+			lit.Value = strconv.Quote(strs[name])
+		}
+	}
+	// protoc-gen-go comments out aliases in the <Enum>_name map, e.g. // Duplicate value: 1: "NAME",
+	// so the comment for a preferred value is swapped with the name it replaces.
+	swaps := make(map[string]string)
+	prefer := func(key ast.Expr, lit *ast.BasicLit) {
+		k, ok := key.(*ast.BasicLit)
+		if !ok || k.Kind != token.INT {
+			return
+		}
+		n, err := strconv.ParseInt(k.Value, 10, 32)
+		if err != nil || preferred[protoreflect.EnumNumber(n)] == nil {
+			return
+		}
+		name := strconv.Quote(string(preferred[protoreflect.EnumNumber(n)].Desc.Name()))
+		swaps[fmt.Sprintf("// Duplicate value: %d: %s,", n, name)] = fmt.Sprintf("// Duplicate value: %d: %s,", n, lit.Value)
+		lit.Value = name
+	}
+	nameMap := p.nameFor(ident.WithSuffix(e.GoIdent, "_name"))
+	valueMap := p.nameFor(ident.WithSuffix(e.GoIdent, "_value"))
+	for _, decl := range gf.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok || len(vs.Names) != 1 || len(vs.Values) != 1 {
+				continue
+			}
+			lit, ok := vs.Values[0].(*ast.CompositeLit)
+			if !ok || (vs.Names[0].Name != nameMap && vs.Names[0].Name != valueMap) {
+				continue
+			}
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				if vs.Names[0].Name == nameMap {
+					if lit, ok := kv.Value.(*ast.BasicLit); ok {
+						prefer(kv.Key, lit)
+						replace(lit)
+					}
+				} else if lit, ok := kv.Key.(*ast.BasicLit); ok {
+					replace(lit)
+				}
+			}
+			if vs.Names[0].Name == nameMap {
+				swapComments(gf, lit, swaps)
+			}
+		}
+	}
+}
+
+// swapComments replaces the text of comments in gf within node that match a key in swaps with its value.
+func swapComments(gf *ast.File, node ast.Node, swaps map[string]string) {
+	for _, cg := range gf.Comments {
+		if cg.Pos() < node.Pos() || cg.End() > node.End() {
+			continue
+		}
+		for _, c := range cg.List {
+			if text, ok := swaps[c.Text]; ok {
+				c.Text = text
+			}
+		}
+	}
+}
+
+// patchEnumStringer patches the String method of e, which may be renamed, to return strings from the <Enum>_name map.
+func (p *Patcher) patchEnumStringer(gf *ast.File, e *protogen.Enum) {
+	fn := findMethod(gf, p.nameFor(e.GoIdent), p.nameFor(ident.WithChild(e.GoIdent, "String")))
+	if fn == nil || len(fn.Recv.List) != 1 || len(fn.Recv.List[0].Names) != 1 || len(fn.Body.List) == 0 {
+		p.warn("unable to find String method for enum", "enum", e.Desc.FullName())
+		return
+	}
+	// New nodes are positioned at the existing body so the printer keeps statements in order.
+	pos := fn.Body.List[0].Pos()
+	newIdent := func(name string) *ast.Ident {
+		return &ast.Ident{NamePos: pos, Name: name}
+	}
+	nameMap := p.nameFor(ident.WithSuffix(e.GoIdent, "_name"))
+	recv := fn.Recv.List[0].Names[0].Name
+	stmt := &ast.IfStmt{
+		If: pos,
+		Init: &ast.AssignStmt{
+			Lhs:    []ast.Expr{newIdent("s"), newIdent("ok")},
+			TokPos: pos,
+			Tok:    token.DEFINE,
+			Rhs: []ast.Expr{&ast.IndexExpr{
+				X:      newIdent(nameMap),
+				Lbrack: pos,
+				Index:  &ast.CallExpr{Fun: newIdent("int32"), Lparen: pos, Args: []ast.Expr{newIdent(recv)}, Rparen: pos},
+				Rbrack: pos,
+			}},
+		},
+		Cond: newIdent("ok"),
+		Body: &ast.BlockStmt{
+			Lbrace: pos,
+			List:   []ast.Stmt{&ast.ReturnStmt{Return: pos, Results: []ast.Expr{newIdent("s")}}},
+			Rbrace: pos,
+		},
+	}
+	fn.Body.List = append([]ast.Stmt{stmt}, fn.Body.List...)
+}
+
+// findMethod returns the declaration of the method with name on typeName in f, or nil if not found.
+func findMethod(f *ast.File, typeName, name string) *ast.FuncDecl {
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || fn.Name.Name != name {
+			continue
+		}
+		typ := fn.Recv.List[0].Type
+		if star, ok := typ.(*ast.StarExpr); ok {
+			typ = star.X
+		}
+		if id, ok := typ.(*ast.Ident); ok && id.Name == typeName {
+			return fn
+		}
+	}
+	return nil
+}
+
+// removeFuncDecl removes function declaration fn, its doc comment, and comments in its body from f.
+func removeFuncDecl(f *ast.File, fn *ast.FuncDecl) {
+	for i, decl := range f.Decls {
+		if decl == fn {
+			f.Decls = append(f.Decls[:i], f.Decls[i+1:]...)
+			break
+		}
+	}
+	pos := fn.Pos()
+	if fn.Doc != nil {
+		pos = fn.Doc.Pos()
+	}
+	comments := f.Comments[:0]
+	for _, cg := range f.Comments {
+		if cg.End() < pos || cg.Pos() > fn.End() {
+			comments = append(comments, cg)
+		}
+	}
+	f.Comments = comments
+}
+
+// generateTextMarshaler generates MarshalText, UnmarshalText, MarshalJSON, and UnmarshalJSON methods for e,
+// using the strings in the <Enum>_name and <Enum>_value maps. UnmarshalText also accepts proto value names.
+func (p *Patcher) generateTextMarshaler(b *bytes.Buffer, e *protogen.Enum, gf *ast.File) {
+	name := p.nameFor(e.GoIdent)
+	nameMap := p.nameFor(ident.WithSuffix(e.GoIdent, "_name"))
+	valueMap := p.nameFor(ident.WithSuffix(e.GoIdent, "_value"))
+	fmtPkg := p.importName(gf, "fmt")
+	jsonPkg := p.importName(gf, "encoding/json")
+	protoreflectPkg := p.importName(gf, "google.golang.org/protobuf/reflect/protoreflect")
+
+	// protoc-gen-go generates a deprecated UnmarshalJSON method for proto2 enums, which is replaced.
+	if fn := findMethod(gf, name, "UnmarshalJSON"); fn != nil {
+		removeFuncDecl(gf, fn)
+	}
+
+	fmt.Fprintf(b, "// MarshalText implements the encoding.TextMarshaler interface.\n")
+	fmt.Fprintf(b, "func (x %s) MarshalText() ([]byte, error) {\n", name)
+	fmt.Fprintf(b, "\tif s, ok := %s[int32(x)]; ok {\n\t\treturn []byte(s), nil\n\t}\n", nameMap)
+	fmt.Fprintf(b, "\treturn nil, %s.Errorf(\"invalid %s value: %%d\", int32(x))\n}\n\n", fmtPkg, name)
+
+	fmt.Fprintf(b, "// UnmarshalText implements the encoding.TextUnmarshaler interface.\n")
+	fmt.Fprintf(b, "func (x *%s) UnmarshalText(b []byte) error {\n", name)
+	fmt.Fprintf(b, "\tif v, ok := %s[string(b)]; ok {\n\t\t*x = %s(v)\n\t\treturn nil\n\t}\n", valueMap, name)
+	fmt.Fprintf(b, "\tif v := x.Descriptor().Values().ByName(%s.Name(b)); v != nil {\n\t\t*x = %s(v.Number())\n\t\treturn nil\n\t}\n", protoreflectPkg, name)
+	fmt.Fprintf(b, "\treturn %s.Errorf(\"invalid %s value: %%q\", b)\n}\n\n", fmtPkg, name)
+
+	fmt.Fprintf(b, "// MarshalJSON implements the json.Marshaler interface.\n")
+	fmt.Fprintf(b, "// Values without a name are encoded as numbers.\n")
+	fmt.Fprintf(b, "func (x %s) MarshalJSON() ([]byte, error) {\n", name)
+	fmt.Fprintf(b, "\tif s, ok := %s[int32(x)]; ok {\n\t\treturn %s.Marshal(s)\n\t}\n", nameMap, jsonPkg)
+	fmt.Fprintf(b, "\treturn %s.Marshal(int32(x))\n}\n\n", jsonPkg)
+
+	fmt.Fprintf(b, "// UnmarshalJSON implements the json.Unmarshaler interface.\n")
+	fmt.Fprintf(b, "// It accepts strings, as with UnmarshalText, and numbers.\n")
+	fmt.Fprintf(b, "func (x *%s) UnmarshalJSON(b []byte) error {\n", name)
+	fmt.Fprintf(b, "\tif string(b) == \"null\" {\n\t\treturn nil\n\t}\n")
+	fmt.Fprintf(b, "\tvar n int32\n\tif err := %s.Unmarshal(b, &n); err == nil {\n\t\t*x = %s(n)\n\t\treturn nil\n\t}\n", jsonPkg, name)
+	fmt.Fprintf(b, "\tvar s string\n\tif err := %s.Unmarshal(b, &s); err != nil {\n\t\treturn err\n\t}\n", jsonPkg)
+	fmt.Fprintf(b, "\treturn x.UnmarshalText([]byte(s))\n}\n\n")
+}
+
+// generateEnumHelpers generates an IsValid method, and <Enum>Values and Parse<Enum> functions for e,
+// using the patched names of the enum type and values.
+func (p *Patcher) generateEnumHelpers(b *bytes.Buffer, e *protogen.Enum, gf *ast.File, preferred map[protoreflect.EnumNumber]*protogen.EnumValue) {
+	name := p.nameFor(e.GoIdent)
+	nameMap := p.nameFor(ident.WithSuffix(e.GoIdent, "_name"))
+	valueMap := p.nameFor(ident.WithSuffix(e.GoIdent, "_value"))
+	fmtPkg := p.importName(gf, "fmt")
+	protoreflectPkg := p.importName(gf, "google.golang.org/protobuf/reflect/protoreflect")
+
+	fmt.Fprintf(b, "// IsValid reports whether x is a declared %s value.\n", name)
+	fmt.Fprintf(b, "func (x %s) IsValid() bool {\n\t_, ok := %s[int32(x)]\n\treturn ok\n}\n\n", name, nameMap)
+
+	fmt.Fprintf(b, "// %sValues returns the declared %s values, in declaration order.\n", name, name)
+	fmt.Fprintf(b, "// Aliases of a value other than the preferred value are omitted.\n")
+	fmt.Fprintf(b, "func %sValues() []%s {\n\treturn []%s{\n", name, name, name)
+	seen := make(map[protoreflect.EnumNumber]bool)
+	for _, v := range e.Values {
+		if seen[v.Desc.Number()] {
+			continue
+		}
+		seen[v.Desc.Number()] = true
+		if pv := preferred[v.Desc.Number()]; pv != nil {
+			v = pv
+		}
+		fmt.Fprintf(b, "\t\t%s,\n", p.nameFor(v.GoIdent))
+	}
+	fmt.Fprintf(b, "\t}\n}\n\n")
+
+	fmt.Fprintf(b, "// Parse%s returns the %s value for s, which may be a string returned by String or a proto value name.\n", name, name)
+	fmt.Fprintf(b, "func Parse%s(s string) (%s, error) {\n", name, name)
+	fmt.Fprintf(b, "\tif v, ok := %s[s]; ok {\n\t\treturn %s(v), nil\n\t}\n", valueMap, name)
+	fmt.Fprintf(b, "\tif v := %s(0).Descriptor().Values().ByName(%s.Name(s)); v != nil {\n\t\treturn %s(v.Number()), nil\n\t}\n", name, protoreflectPkg, name)
+	fmt.Fprintf(b, "\treturn 0, %s.Errorf(\"invalid %s value: %%q\", s)\n}\n\n", fmtPkg, name)
+}
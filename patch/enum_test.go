@@ -0,0 +1,86 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/alta/protopatch/patch/gopb"
+	"github.com/alta/protopatch/tests/enum"
+)
+
+func TestEnumStringConflicts(t *testing.T) {
+	req := testRequest("paths=import", enum.File_tests_enum_enum_strings_proto)
+	res := testPatch(t, req, WithStrict(true))
+	assert.Nil(t, res.Error)
+
+	for _, fd := range req.ProtoFile {
+		if fd.GetName() != enum.File_tests_enum_enum_strings_proto.Path() {
+			continue
+		}
+		for _, v := range fd.EnumType[0].Value {
+			if v.GetName() == "COLOR_BLUE" {
+				v.Options = &descriptorpb.EnumValueOptions{}
+				proto.SetExtension(v.Options, gopb.E_Value, &gopb.Options{String_: proto.String("COLOR_RED")})
+			}
+		}
+	}
+	res = testPatch(t, req, WithStrict(true))
+	if assert.NotNil(t, res.Error) {
+		assert.Equal(t, "protopatch: enum value string conflicts with another value value=tests.enum.COLOR_BLUE string=COLOR_RED", res.GetError())
+	}
+}
+
+func TestEnumValueNameConflicts(t *testing.T) {
+	req := testRequest("paths=import", enum.File_tests_enum_enum_aliases_proto)
+	res := testPatch(t, req, WithStrict(true))
+	assert.Nil(t, res.Error)
+
+	for _, fd := range req.ProtoFile {
+		if fd.GetName() != enum.File_tests_enum_enum_aliases_proto.Path() {
+			continue
+		}
+		for _, v := range fd.EnumType[0].Value {
+			if v.GetName() == "PRIORITY_HIGH" {
+				v.Options = &descriptorpb.EnumValueOptions{}
+				proto.SetExtension(v.Options, gopb.E_Value, &gopb.Options{Name: proto.String("PriorityLow")})
+			}
+		}
+	}
+	res = testPatch(t, req, WithStrict(true))
+	if assert.NotNil(t, res.Error) {
+		assert.Equal(t, "protopatch: enum value name conflicts with another value value=tests.enum.PRIORITY_HIGH name=PriorityLow conflict=tests.enum.PRIORITY_LOW", res.GetError())
+	}
+}
+
+func TestPreferredEnumValues(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"PRIORITY_LOW", "protopatch: enum value number has more than one preferred value value=tests.enum.PRIORITY_MINOR number=1"},
+		{"PRIORITY_UNSPECIFIED", "protopatch: preferred enum value has no aliases value=tests.enum.PRIORITY_UNSPECIFIED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testRequest("paths=import", enum.File_tests_enum_enum_aliases_proto)
+			for _, fd := range req.ProtoFile {
+				if fd.GetName() != enum.File_tests_enum_enum_aliases_proto.Path() {
+					continue
+				}
+				for _, v := range fd.EnumType[0].Value {
+					if v.GetName() == tt.name {
+						v.Options = &descriptorpb.EnumValueOptions{}
+						proto.SetExtension(v.Options, gopb.E_Value, &gopb.Options{Preferred: proto.Bool(true)})
+					}
+				}
+			}
+			res := testPatch(t, req, WithStrict(true))
+			if assert.NotNil(t, res.Error) {
+				assert.Equal(t, tt.want, res.GetError())
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package patch
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/alta/protopatch/patch/ident"
+)
+
+// generateAccessors generates Get and Set functions for each extension in f with the accessors option.
+// The extended message type and extension type are read from the extension info in the patched Go file gf.
+func (p *Patcher) generateAccessors(b *bytes.Buffer, f *protogen.File, gf *ast.File) {
+	var exts []*protogen.Extension
+	exts = append(exts, f.Extensions...)
+	walkMessages(f.Messages, func(m *protogen.Message) {
+		exts = append(exts, m.Extensions...)
+	})
+	var infos map[string]*ast.CompositeLit
+	for _, x := range exts {
+		if !accessorsOption(x) {
+			continue
+		}
+		if infos == nil {
+			infos = extensionInfos(gf)
+		}
+		info := infos[string(x.Desc.FullName())]
+		if info == nil {
+			p.warn("unable to find extension info for accessors", "extension", x.Desc.FullName())
+			continue
+		}
+		p.generateAccessor(b, x, info, gf)
+	}
+}
+
+func (p *Patcher) generateAccessor(b *bytes.Buffer, x *protogen.Extension, info *ast.CompositeLit, gf *ast.File) {
+	extendedType := nilType(infoValue(info, "ExtendedType"))
+	extensionType := nilType(infoValue(info, "ExtensionType"))
+	if extendedType == nil || extensionType == nil {
+		p.warn("unable to find extension types for accessors", "extension", x.Desc.FullName())
+		return
+	}
+	// Singular scalar and enum extensions are declared as pointers, but proto.GetExtension returns values.
+	if star, ok := extensionType.(*ast.StarExpr); ok && x.Message == nil && !x.Desc.IsList() {
+		extensionType = star.X
+	}
+	typ := types.ExprString(extensionType)
+	varName := p.nameFor(ident.WithPrefix(x.GoIdent, "E_"))
+	name := strings.TrimPrefix(varName, "E_")
+	protoPkg := p.importName(gf, "google.golang.org/protobuf/proto")
+
+	get := fmt.Sprintf("%s.GetExtension(m, %s).(%s)", protoPkg, varName, typ)
+	set := "v"
+	accessorType := typ
+	if fieldType := fieldOptions(x).GetType(); fieldType != "" {
+		switch {
+		case x.Message != nil && !x.Desc.IsList():
+			p.warn("type declared for message extension", "extension", x.Desc.FullName())
+		case isTypeValid(fieldType):
+			p.warn("extension has invalid type option", "extension", x.Desc.FullName(), "type", fieldType)
+		default:
+			accessorType = fieldType
+			get = fmt.Sprintf("%s(%s)", fieldType, get)
+			set = fmt.Sprintf("%s(v)", typ)
+		}
+	}
+
+	fmt.Fprintf(b, "// Get%s returns the value of the %s extension of m.\n", name, x.Desc.FullName())
+	fmt.Fprintf(b, "func Get%s(m %s) %s {\n\treturn %s\n}\n\n", name, types.ExprString(extendedType), accessorType, get)
+	fmt.Fprintf(b, "// Set%s sets the value of the %s extension of m.\n", name, x.Desc.FullName())
+	fmt.Fprintf(b, "func Set%s(m %s, v %s) {\n\t%s.SetExtension(m, %s, %s)\n}\n\n", name, types.ExprString(extendedType), accessorType, protoPkg, varName, set)
+}
+
+// accessorsOption reports whether accessors should be generated for extension x,
+// from the extension or file options.
+func accessorsOption(x *protogen.Extension) bool {
+	if opts := fieldOptions(x); opts != nil && opts.Accessors != nil {
+		return opts.GetAccessors()
+	}
+	return fileOptions(x.Desc).GetAccessors()
+}
+
+// extensionInfos returns the elements of the []protoimpl.ExtensionInfo table in f, keyed by extension full name.
+func extensionInfos(f *ast.File) map[string]*ast.CompositeLit {
+	infos := make(map[string]*ast.CompositeLit)
+	ast.Inspect(f, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		at, ok := lit.Type.(*ast.ArrayType)
+		if !ok {
+			return true
+		}
+		if sel, ok := at.Elt.(*ast.SelectorExpr); !ok || sel.Sel.Name != "ExtensionInfo" {
+			return true
+		}
+		for _, elt := range lit.Elts {
+			info, ok := elt.(*ast.CompositeLit)
+			if !ok {
+				continue
+			}
+			if name, ok := infoValue(info, "Name").(*ast.BasicLit); ok {
+				if s, err := strconv.Unquote(name.Value); err == nil {
+					infos[s] = info
+				}
+			}
+		}
+		return false
+	})
+	return infos
+}
+
+// infoValue returns the value of the element with key in composite literal lit, or nil if not found.
+func infoValue(lit *ast.CompositeLit, key string) ast.Expr {
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok && isIdent(kv.Key, key) {
+			return kv.Value
+		}
+	}
+	return nil
+}
+
+// nilType returns the type T of a typed nil expression (T)(nil), or nil if expr is not a typed nil.
+func nilType(expr ast.Expr) ast.Expr {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || !isIdent(call.Args[0], "nil") {
+		return nil
+	}
+	if paren, ok := call.Fun.(*ast.ParenExpr); ok {
+		return paren.X
+	}
+	return call.Fun
+}
@@ -0,0 +1,46 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/alta/protopatch/patch/gopb"
+	"github.com/alta/protopatch/tests/message"
+)
+
+func TestExtensionAccessorTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  string
+		want string
+	}{
+		{"iota", "Custom", "protopatch: type declared for message extension extension=tests.message.iota"},
+		{"epsilon", "pkg.Custom", "protopatch: extension has invalid type option extension=tests.message.epsilon type=pkg.Custom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testRequest("paths=import", message.File_tests_message_message_extension_accessors_proto)
+			res := testPatch(t, req, WithStrict(true))
+			assert.Nil(t, res.Error)
+
+			for _, fd := range req.ProtoFile {
+				if fd.GetName() != message.File_tests_message_message_extension_accessors_proto.Path() {
+					continue
+				}
+				for _, x := range fd.Extension {
+					if x.GetName() == tt.name {
+						x.Options = &descriptorpb.FieldOptions{}
+						proto.SetExtension(x.Options, gopb.E_Field, &gopb.Options{Type: proto.String(tt.typ)})
+					}
+				}
+			}
+			res = testPatch(t, req, WithStrict(true))
+			if assert.NotNil(t, res.Error) {
+				assert.Equal(t, tt.want, res.GetError())
+			}
+		})
+	}
+}
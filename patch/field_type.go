@@ -5,11 +5,15 @@ import (
 	"go/ast"
 	"go/token"
 	"go/types"
-	"log"
+	"sort"
 	"strings"
 )
 
 func (p *Patcher) patchTypeDef(id *ast.Ident, obj types.Object) {
+	if typ, ok := p.fieldElemTypes[obj]; ok {
+		p.patchElemTypeDef(id, obj, typ)
+		return
+	}
 	fieldType, ok := p.fieldTypes[obj]
 	if !ok {
 		return
@@ -20,7 +24,7 @@ func (p *Patcher) patchTypeDef(id *ast.Ident, obj types.Object) {
 		case *ast.Ident:
 			t.Name = fieldType
 			return true
-		case *ast.ArrayType:
+		case *ast.ArrayType, *ast.MapType:
 			v.Type = &ast.Ident{
 				Name: fieldType,
 			}
@@ -39,11 +43,11 @@ func (p *Patcher) patchTypeDef(id *ast.Ident, obj types.Object) {
 	if id.Obj != nil && id.Obj.Decl != nil {
 		v, ok := id.Obj.Decl.(*ast.Field)
 		if !ok {
-			log.Printf("Warning: fieldType declared for non-field object: %v `%s`", obj, fieldType)
+			p.warn("fieldType declared for non-field object", "object", obj, "type", fieldType)
 			return
 		}
 		if !castDecl(v) {
-			log.Printf("Warning: unsupported fieldType type: %T `%s`", v.Type, fieldType)
+			p.warn("unsupported fieldType type", "expr", fmt.Sprintf("%T", v.Type), "type", fieldType)
 		}
 		return
 	}
@@ -53,35 +57,39 @@ func (p *Patcher) patchTypeDef(id *ast.Ident, obj types.Object) {
 		parent := p.findParentNode(id)
 		n, ok := parent.(*ast.FuncDecl)
 		if !ok {
-			log.Printf("Warning: unexpected type for getter: %v `%T`", obj, parent)
+			p.warn("unexpected type for getter", "object", obj, "node", fmt.Sprintf("%T", parent))
 			break
 		}
 		if l := len(n.Type.Results.List); l != 1 {
-			log.Printf("Warning: unexpected return count for getter: %v `%d`", obj, l)
+			p.warn("unexpected return count for getter", "object", obj, "count", l)
 			return
 		}
 		if !castDecl(n.Type.Results.List[0]) {
-			log.Printf("Warning: unsupported fieldType type: %T `%s`", n.Type.Results.List[0].Type, fieldType)
+			p.warn("unsupported fieldType type", "expr", fmt.Sprintf("%T", n.Type.Results.List[0].Type), "type", fieldType)
+			return
+		}
+		if _, ok := obj.Type().(*types.Signature).Results().At(0).Type().(*types.Named); ok {
+			p.castDefaultReturns(n, fieldType)
 		}
 		return
 	}
 }
 
 func (p *Patcher) patchTypeUsage(id *ast.Ident, obj types.Object) {
+	if typ, ok := p.fieldElemTypes[obj]; ok {
+		p.patchElemTypeUsage(id, obj, typ)
+		return
+	}
 	desiredType, ok := p.fieldTypes[obj]
 	if !ok {
 		return
 	}
 	// we don't want to modify the getter body,
 	// so we check if we are in the getter declaration body ?
-	node := ast.Node(id)
-	for{
-		node = p.findParentNode(node)
-		if node == nil {
-			break
-		}
+	for node := p.findParentNode(id); node != nil; node = p.findParentNode(node) {
 		if fn, ok := node.(*ast.FuncDecl); ok {
-			if fn.Name.String() == "Get"+id.Name {
+			// Compare objects rather than names, as the getter may be renamed.
+			if _, ok := p.fieldTypes[p.info.Defs[fn.Name]]; ok && fn.Recv != nil {
 				return
 			}
 			break
@@ -92,24 +100,28 @@ func (p *Patcher) patchTypeUsage(id *ast.Ident, obj types.Object) {
 	case *types.Basic:
 		originalType = t.Name()
 	case *types.Pointer:
-		originalType = t.String()
+		originalType = types.TypeString(t, relativeQualifier(obj.Pkg()))
 		desiredType = "*"+desiredType
 	case *types.Signature:
 		if t.Results().Len() != 1 {
 			return
 		}
-		originalType = t.Results().At(0).Type().String()
+		originalType = types.TypeString(t.Results().At(0).Type(), relativeQualifier(obj.Pkg()))
+	case *types.Named, *types.Map, *types.Slice:
+		originalType = types.TypeString(t, relativeQualifier(obj.Pkg()))
 	}
 	cast := func(as string, expr ast.Expr) ast.Expr {
 		if strings.HasPrefix(as, "*") {
 			as = fmt.Sprintf("(%s)", as)
 		}
-		return &ast.CallExpr{
+		call := &ast.CallExpr{
 			Fun: &ast.Ident{
 				Name: as,
 			},
 			Args: []ast.Expr{expr},
 		}
+		p.replaceParent(expr, call)
+		return call
 	}
 
 	expr := p.findParentNode(id)
@@ -214,3 +226,102 @@ func isTypeValid(typeName string) bool {
 		strings.Contains(typeName, "[]") ||
 		strings.Contains(typeName, "*")
 }
+
+// verifyFieldTypes returns an error for each (go.field).type cast that cannot be converted from the field’s Go type.
+// Casts are only verified if the cast type is found in export data for the field’s package.
+func (p *Patcher) verifyFieldTypes() []error {
+	if p.exports == nil {
+		return nil
+	}
+	objs := make([]types.Object, 0, len(p.fieldTypes))
+	for obj := range p.fieldTypes {
+		objs = append(objs, obj)
+	}
+	sort.Slice(objs, func(i, j int) bool { return objs[i].Pos() < objs[j].Pos() })
+	var errs []error
+	for _, obj := range objs {
+		fieldType := p.fieldTypes[obj]
+		if isTypeValid(fieldType) || obj.Pkg() == nil {
+			continue
+		}
+		var typ types.Type
+		switch t := obj.Type().(type) {
+		case *types.Signature:
+			if t.Results().Len() != 1 {
+				continue
+			}
+			typ = t.Results().At(0).Type()
+		default:
+			typ = t
+		}
+		if ptr, ok := typ.(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+		if !isBasicType(typ) {
+			continue
+		}
+		pkg, _ := p.exports.Import(obj.Pkg().Path())
+		if pkg == nil {
+			continue
+		}
+		tn, ok := pkg.Scope().Lookup(fieldType).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		if !types.ConvertibleTo(typ, tn.Type()) {
+			errs = append(errs, fmt.Errorf("cannot convert %v to fieldType `%s` (%v)", obj, fieldType, tn.Type().Underlying()))
+		}
+	}
+	return errs
+}
+
+// isBasicType reports whether typ is a basic type or a slice of a basic type,
+// which are identical across separately type-checked packages.
+func isBasicType(typ types.Type) bool {
+	if s, ok := typ.(*types.Slice); ok {
+		typ = s.Elem()
+	}
+	_, ok := typ.(*types.Basic)
+	return ok
+}
+
+// castDefaultReturns casts the typed constants returned by getter fn, such as the default value of an enum field,
+// to fieldType. Other return values are expected to be fields that are already cast to fieldType.
+func (p *Patcher) castDefaultReturns(fn *ast.FuncDecl, fieldType string) {
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		ret, ok := n.(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 {
+			return true
+		}
+		if _, ok := p.info.Uses[constIdent(ret.Results[0])].(*types.Const); ok {
+			ret.Results[0] = &ast.CallExpr{
+				Fun:  &ast.Ident{Name: fieldType},
+				Args: []ast.Expr{ret.Results[0]},
+			}
+		}
+		return false
+	})
+}
+
+// constIdent returns the identifier of a possibly package-qualified name in expr, or nil if expr is not a name.
+func constIdent(expr ast.Expr) *ast.Ident {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e
+	case *ast.SelectorExpr:
+		if _, ok := e.X.(*ast.Ident); ok {
+			return e.Sel
+		}
+	}
+	return nil
+}
+
+// relativeQualifier returns a types.Qualifier that omits the name of pkg, and qualifies other packages by name.
+func relativeQualifier(pkg *types.Package) types.Qualifier {
+	return func(other *types.Package) string {
+		if other == pkg {
+			return ""
+		}
+		return other.Name()
+	}
+}
@@ -1,10 +1,12 @@
 package patch
 
 import (
+	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/token"
 	"go/types"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,12 +14,20 @@ import (
 )
 
 func prepareCastType(src string) (*Patcher, *ast.File, error) {
+	const fieldType = "String"
+	return preparePatch(src, func(p *Patcher, field, getter protogen.GoIdent) {
+		p.Type(field, fieldType)
+		p.Type(getter, fieldType)
+	})
+}
+
+// preparePatch type-checks src, and calls setup with the identifiers of the Message.Content field and its getter.
+func preparePatch(src string, setup func(p *Patcher, field, getter protogen.GoIdent)) (*Patcher, *ast.File, error) {
 	const (
 		fileName    = "foo.go"
 		packageName = "foo"
 		fieldName   = "Content"
-		msgName   = "Message"
-		fieldType = "String"
+		msgName     = "Message"
 	)
 	p, err := NewPatcher(&protogen.Plugin{})
 	if err != nil {
@@ -45,8 +55,9 @@ func prepareCastType(src string) (*Patcher, *ast.File, error) {
 	p.packagesByPath[packageName] = pkg
 	p.packagesByName[packageName] = pkg
 	p.packages = append(p.packages, pkg)
-	p.Type(protogen.GoIdent{GoName: msgName + "." + fieldName, GoImportPath: packageName}, fieldType)
-	p.Type(protogen.GoIdent{GoName: msgName + "." + "Get" + fieldName, GoImportPath: packageName}, fieldType)
+	setup(p,
+		protogen.GoIdent{GoName: msgName + "." + fieldName, GoImportPath: packageName},
+		protogen.GoIdent{GoName: msgName + "." + "Get" + fieldName, GoImportPath: packageName})
 	// Map cast types
 	for id, typ := range p.types {
 		obj, _ := p.find(id)
@@ -55,6 +66,13 @@ func prepareCastType(src string) (*Patcher, *ast.File, error) {
 		}
 		p.fieldTypes[obj] = typ
 	}
+	for id, typ := range p.elemTypes {
+		obj, _ := p.find(id)
+		if obj == nil {
+			continue
+		}
+		p.fieldElemTypes[obj] = typ
+	}
 	return p, file, nil
 }
 
@@ -471,3 +489,170 @@ func useContentGetter(msg *Message) {
 	}
 
 }
+
+func BenchmarkPatchTypeUsage(b *testing.B) {
+	var src strings.Builder
+	src.WriteString(`package foo
+
+type String string
+
+type Message struct {
+	Content string
+}
+
+func (m *Message) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func print(s string) {}
+`)
+	for i := 0; i < 1000; i++ {
+		fmt.Fprintf(&src, `
+func useContent%d(msg *Message) {
+	var s string
+	s = msg.Content
+	msg.Content = s
+	print(msg.GetContent())
+}
+`, i)
+	}
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		p, _, err := prepareCastType(src.String())
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		if err := p.patchGoFiles(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestNamedMapCastType(t *testing.T) {
+	const src = `package foo
+
+type Totals map[string]int64
+
+type Message struct {
+	Content map[string]int64
+}
+
+func (m *Message) GetContent() map[string]int64 {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func useContent(msg *Message) {
+	m := map[string]int64{}
+	msg.Content = m
+	m = msg.Content
+	_ = &Message{Content: m}
+}
+`
+	const want = `package foo
+
+type Totals map[string]int64
+
+type Message struct {
+	Content Totals
+}
+
+func (m *Message) GetContent() Totals {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func useContent(msg *Message) {
+	m := map[string]int64{}
+	msg.Content = Totals(m)
+	m = map[string]int64(msg.Content)
+	_ = &Message{Content: Totals(m)}
+}
+`
+	p, file, err := preparePatch(src, func(p *Patcher, field, getter protogen.GoIdent) {
+		p.Type(field, "Totals")
+		p.Type(getter, "Totals")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.patchGoFiles(); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, want, p.nodeToString(file))
+}
+
+func TestEnumCastType(t *testing.T) {
+	const src = `package foo
+
+type Color int32
+
+const Color_RED Color = 0
+
+type Shade Color
+
+type Message struct {
+	Content Color
+}
+
+func (m *Message) GetContent() Color {
+	if m != nil {
+		return m.Content
+	}
+	return Color_RED
+}
+
+func useContent(msg *Message) {
+	c := Color_RED
+	msg.Content = c
+	c = msg.Content
+	_ = &Message{Content: c}
+}
+`
+	const want = `package foo
+
+type Color int32
+
+const Color_RED Color = 0
+
+type Shade Color
+
+type Message struct {
+	Content Shade
+}
+
+func (m *Message) GetContent() Shade {
+	if m != nil {
+		return m.Content
+	}
+	return Shade(Color_RED)
+}
+
+func useContent(msg *Message) {
+	c := Color_RED
+	msg.Content = Shade(c)
+	c = Color(msg.Content)
+	_ = &Message{Content: Shade(c)}
+}
+`
+	p, file, err := preparePatch(src, func(p *Patcher, field, getter protogen.GoIdent) {
+		p.Type(field, "Shade")
+		p.Type(getter, "Shade")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.patchGoFiles(); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, want, p.nodeToString(file))
+}
@@ -0,0 +1,148 @@
+package patch
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// runtimeImportPath is the import path of the runtime package, which generated code registers patched Go names with.
+const runtimeImportPath = "github.com/alta/protopatch/runtime"
+
+// alias is a renamed Go type or value that should be aliased with its original name.
+type alias struct {
+	id    protogen.GoIdent
+	value bool
+}
+
+// generate generates additional Go declarations for each proto file being generated.
+// The declarations are appended to the generated Go file when it is serialized.
+func (p *Patcher) generate() {
+	for _, f := range p.gen.Files {
+		if !f.Generate {
+			continue
+		}
+		filename := p.goFilename(f, ".pb.go")
+		if p.filesByName[filename] == nil {
+			continue
+		}
+		p.patchExporters(p.filesByName[filename])
+		p.patchDocs(f, p.filesByName[filename])
+		b := &bytes.Buffer{}
+		p.generateAliases(b, f)
+		p.generateEnums(b, f, p.filesByName[filename])
+		p.generateConstructors(b, f, p.filesByName[filename])
+		p.generateSetters(b, f, p.filesByName[filename])
+		p.generateAccessors(b, f, p.filesByName[filename])
+		p.generateImplements(b, f, p.filesByName[filename])
+		if fileOptions(f.Desc).GetRegistry() {
+			astutil.AddNamedImport(p.fset, p.filesByName[filename], "protopatch", runtimeImportPath)
+			p.generateRegistry(b, f)
+		}
+		if b.Len() > 0 {
+			p.log.Debug("generate go", "file", filename, "code", b.String())
+			p.decls[filename] = b.Bytes()
+		}
+	}
+	p.verifyImplements()
+}
+
+// goFilename returns the name of the Go file generated for f with suffix,
+// relative to the module param, if specified.
+func (p *Patcher) goFilename(f *protogen.File, suffix string) string {
+	filename := f.GeneratedFilenamePrefix + suffix
+	if p.module != "" {
+		filename = strings.TrimPrefix(filename, p.module+"/")
+	}
+	return filename
+}
+
+// importName returns the name that Go file gf uses to refer to the package with import path,
+// adding a named import of the package to gf if necessary, as protoc-gen-go does.
+func (p *Patcher) importName(gf *ast.File, path string) string {
+	for _, spec := range gf.Imports {
+		if importPath, _ := strconv.Unquote(spec.Path.Value); importPath != path {
+			continue
+		}
+		if spec.Name != nil && spec.Name.Name != "_" && spec.Name.Name != "." {
+			return spec.Name.Name
+		}
+		if spec.Name == nil {
+			return p.packageName(path)
+		}
+	}
+	name := p.packageName(path)
+	astutil.AddNamedImport(p.fset, gf, name, path)
+	return name
+}
+
+// packageName returns the name of the Go package with import path,
+// from the packages being patched, export data, or the conventional name for path.
+func (p *Patcher) packageName(path string) string {
+	if pkg := p.getPackage(path, "", false); pkg != nil && len(pkg.files) > 0 {
+		return pkg.pkg.Name()
+	}
+	if p.exports != nil {
+		if pkg, _ := p.exports.Import(path); pkg != nil {
+			return pkg.Name()
+		}
+	}
+	return defaultPackageName(path)
+}
+
+func (p *Patcher) generateAliases(b *bytes.Buffer, f *protogen.File) {
+	var types, values bytes.Buffer
+	for _, a := range p.aliases[f.Desc.Path()] {
+		newName := p.nameFor(a.id)
+		if newName == a.id.GoName {
+			continue
+		}
+		w := &types
+		if a.value {
+			w = &values
+		}
+		fmt.Fprintf(w, "\t// %s is an alias for %s.\n", a.id.GoName, newName)
+		fmt.Fprintf(w, "\t%s = %s\n", a.id.GoName, newName)
+	}
+	if types.Len() > 0 {
+		fmt.Fprintf(b, "// Aliases for renamed types.\ntype (\n%s)\n\n", types.String())
+	}
+	if values.Len() > 0 {
+		fmt.Fprintf(b, "// Aliases for renamed values.\nconst (\n%s)\n\n", values.String())
+	}
+}
+
+// generateRegistry generates an init function that registers the patched Go names
+// of every element in f with the runtime package.
+func (p *Patcher) generateRegistry(b *bytes.Buffer, f *protogen.File) {
+	mf := p.Manifest(f)
+	fmt.Fprintf(b, "// Register patched Go names with the protopatch runtime.\n")
+	fmt.Fprintf(b, "func init() {\n\tprotopatch.Register(protopatch.Names{\n")
+	name := func(fullName, goName string) {
+		fmt.Fprintf(b, "\t\t%q: %q,\n", fullName, goName)
+	}
+	for _, m := range mf.Messages {
+		name(m.FullName, m.GoName)
+		for _, o := range m.Oneofs {
+			name(o.FullName, o.GoName)
+		}
+		for _, f := range m.Fields {
+			name(f.FullName, f.GoName)
+		}
+	}
+	for _, e := range mf.Enums {
+		name(e.FullName, e.GoName)
+		for _, v := range e.Values {
+			name(v.FullName, v.GoName)
+		}
+	}
+	for _, x := range mf.Extensions {
+		name(x.FullName, x.GoName)
+	}
+	fmt.Fprintf(b, "\t})\n}\n\n")
+}
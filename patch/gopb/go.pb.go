@@ -22,6 +22,7 @@ const (
 )
 
 // Options represent Go-specific options for Protobuf messages, fields, oneofs, enums, or enum values.
+// Defaults for some options can be specified for every element in a file with FileOptions.
 type Options struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -38,22 +39,82 @@ type Options struct {
 	// Only message types can be embedded. Oneof fields cannot be embedded.
 	// See https://golang.org/ref/spec#Struct_types.
 	Embed *bool `protobuf:"varint,2,opt,name=embed" json:"embed,omitempty"`
+	// The private option unexports the generated Go struct field of a message field, e.g. Name → name.
+	// The getter method remains exported. Oneof fields and embedded fields cannot be private.
+	Private *bool `protobuf:"varint,4,opt,name=private" json:"private,omitempty"`
 	// The type option changes the generated field type.
 	// All generated code assumes that this type is castable to the protocol buffer field type.
 	Type *string `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
+	// The key_type option changes the Go type of the keys of a map field, e.g. map[string]int64 → map[UserID]int64.
+	// All generated code assumes that this type is castable to the protocol buffer map key type.
+	KeyType *string `protobuf:"bytes,40,opt,name=key_type,json=keyType" json:"key_type,omitempty"`
+	// The value_type option changes the Go type of the values of a map field, e.g. map[string]int64 → map[string]Score.
+	// All generated code assumes that this type is castable to the protocol buffer map value type.
+	// Message values cannot be changed.
+	ValueType *string `protobuf:"bytes,41,opt,name=value_type,json=valueType" json:"value_type,omitempty"`
+	// The elem_type option changes the Go type of the elements of a repeated field, e.g. []string → []UserID.
+	// All generated code assumes that this type is castable to the protocol buffer field type.
+	// Message elements cannot be changed.
+	ElemType *string `protobuf:"bytes,42,opt,name=elem_type,json=elemType" json:"elem_type,omitempty"`
+	// The alias option generates a Go alias with the original name of a renamed message, enum, or enum value.
+	// For an enum, this also applies to its values.
+	Alias *bool `protobuf:"varint,7,opt,name=alias" json:"alias,omitempty"`
 	// The getter option renames the generated getter method (default: Get<Field>)
 	// so a custom getter can be implemented in its place.
-	Getter *string `protobuf:"bytes,10,opt,name=getter" json:"getter,omitempty"` // TODO: implement this
+	Getter *string `protobuf:"bytes,10,opt,name=getter" json:"getter,omitempty"`
+	// The setters option generates a Set<Field> method for each field of a message.
+	// Specified on a message field, it overrides the message option for that field.
+	// Setters for optional scalar fields take a value, and setters for oneof fields set the oneof to the field.
+	Setters *bool `protobuf:"varint,11,opt,name=setters" json:"setters,omitempty"`
+	// The constructor option generates a New<Message> function for a message, which takes functional options,
+	// and a <Message>Option type with a With<Message><Field> option for each field.
+	// Option names include the message name, so options for messages in the same Go package do not conflict.
+	// The options use the patched field names and types.
+	// A constructor or option whose name conflicts with another declaration is not generated.
+	Constructor *bool `protobuf:"varint,12,opt,name=constructor" json:"constructor,omitempty"`
+	// The accessors option generates Get<Extension> and Set<Extension> functions for an extension,
+	// which wrap proto.GetExtension and proto.SetExtension with the extended message type and the extension’s Go type.
+	// The type option changes the Go type of the accessors of an extension.
+	Accessors *bool `protobuf:"varint,13,opt,name=accessors" json:"accessors,omitempty"`
+	// The implements option declares that a message implements one or more Go interfaces,
+	// specified by import path and name, e.g. "github.com/acme/domain.Entity" or "fmt.Stringer".
+	// A compile-time assertion is generated for each interface.
+	// Interfaces without an import path are in the same Go package as the message.
+	Implements []string `protobuf:"bytes,50,rep,name=implements" json:"implements,omitempty"`
 	// The tags option specifies additional struct tags which are appended a generated Go struct field.
 	// This option may be specified on a message field or a oneof field.
 	// The value should omit the enclosing backticks.
 	Tags *string `protobuf:"bytes,20,opt,name=tags" json:"tags,omitempty"`
+	// The doc option replaces the Go doc comment of a message, field, enum, or enum value,
+	// which is generated from the leading comments in the proto file. An empty value removes the doc comment.
+	// Lines are separated by newlines. A deprecation notice generated for a deprecated element is kept.
+	Doc *string `protobuf:"bytes,60,opt,name=doc" json:"doc,omitempty"`
+	// The doc_append option appends a paragraph to the Go doc comment of a message, field, enum, or enum value.
+	DocAppend *string `protobuf:"bytes,61,opt,name=doc_append,json=docAppend" json:"doc_append,omitempty"`
+	// The deprecated_message option replaces the deprecation notice generated for a deprecated message, field, enum,
+	// or enum value, e.g. “Use name instead.” replaces the notice with “Deprecated: Use name instead.”
+	// It is ignored with a warning if the element is not deprecated.
+	DeprecatedMessage *string `protobuf:"bytes,62,opt,name=deprecated_message,json=deprecatedMessage" json:"deprecated_message,omitempty"`
 	// The stringer option renames a generated String() method (if any)
 	// so a custom String() method can be implemented in its place.
 	Stringer *string `protobuf:"bytes,30,opt,name=stringer" json:"stringer,omitempty"` // TODO: implement for messages
 	// The stringer_name option is a deprecated alias for stringer.
 	// It will be removed in a future version of this package.
 	StringerName *string `protobuf:"bytes,31,opt,name=stringer_name,json=stringerName" json:"stringer_name,omitempty"`
+	// The string option specifies a custom string for an enum value, used by String() and MarshalText
+	// in place of the proto value name, e.g. COLOR_RED → red. The proto value name is unchanged.
+	// The string replaces the value name in the generated <Enum>_name and <Enum>_value maps.
+	String_ *string `protobuf:"bytes,32,opt,name=string" json:"string,omitempty"`
+	// The text_marshaler option generates MarshalText, UnmarshalText, MarshalJSON, and UnmarshalJSON methods for an enum,
+	// which encode enum values as strings, so enums round-trip with encoding/json and other encoders outside of protojson.
+	TextMarshaler *bool `protobuf:"varint,33,opt,name=text_marshaler,json=textMarshaler" json:"text_marshaler,omitempty"`
+	// The helpers option generates helpers for an enum: an IsValid method, a <Enum>Values function that returns
+	// the enum values, and a Parse<Enum> function that parses a string returned by String or a proto value name.
+	Helpers *bool `protobuf:"varint,34,opt,name=helpers" json:"helpers,omitempty"`
+	// The preferred option marks the enum value whose name is returned by String() for a number shared by aliases,
+	// in an enum with allow_alias. By default, the first declared value is preferred.
+	// Only one value of each number may be preferred.
+	Preferred *bool `protobuf:"varint,35,opt,name=preferred" json:"preferred,omitempty"`
 }
 
 func (x *Options) Reset() {
@@ -102,6 +163,13 @@ func (x *Options) GetEmbed() bool {
 	return false
 }
 
+func (x *Options) GetPrivate() bool {
+	if x != nil && x.Private != nil {
+		return *x.Private
+	}
+	return false
+}
+
 func (x *Options) GetType() string {
 	if x != nil && x.Type != nil {
 		return *x.Type
@@ -109,6 +177,34 @@ func (x *Options) GetType() string {
 	return ""
 }
 
+func (x *Options) GetKeyType() string {
+	if x != nil && x.KeyType != nil {
+		return *x.KeyType
+	}
+	return ""
+}
+
+func (x *Options) GetValueType() string {
+	if x != nil && x.ValueType != nil {
+		return *x.ValueType
+	}
+	return ""
+}
+
+func (x *Options) GetElemType() string {
+	if x != nil && x.ElemType != nil {
+		return *x.ElemType
+	}
+	return ""
+}
+
+func (x *Options) GetAlias() bool {
+	if x != nil && x.Alias != nil {
+		return *x.Alias
+	}
+	return false
+}
+
 func (x *Options) GetGetter() string {
 	if x != nil && x.Getter != nil {
 		return *x.Getter
@@ -116,6 +212,34 @@ func (x *Options) GetGetter() string {
 	return ""
 }
 
+func (x *Options) GetSetters() bool {
+	if x != nil && x.Setters != nil {
+		return *x.Setters
+	}
+	return false
+}
+
+func (x *Options) GetConstructor() bool {
+	if x != nil && x.Constructor != nil {
+		return *x.Constructor
+	}
+	return false
+}
+
+func (x *Options) GetAccessors() bool {
+	if x != nil && x.Accessors != nil {
+		return *x.Accessors
+	}
+	return false
+}
+
+func (x *Options) GetImplements() []string {
+	if x != nil {
+		return x.Implements
+	}
+	return nil
+}
+
 func (x *Options) GetTags() string {
 	if x != nil && x.Tags != nil {
 		return *x.Tags
@@ -123,6 +247,27 @@ func (x *Options) GetTags() string {
 	return ""
 }
 
+func (x *Options) GetDoc() string {
+	if x != nil && x.Doc != nil {
+		return *x.Doc
+	}
+	return ""
+}
+
+func (x *Options) GetDocAppend() string {
+	if x != nil && x.DocAppend != nil {
+		return *x.DocAppend
+	}
+	return ""
+}
+
+func (x *Options) GetDeprecatedMessage() string {
+	if x != nil && x.DeprecatedMessage != nil {
+		return *x.DeprecatedMessage
+	}
+	return ""
+}
+
 func (x *Options) GetStringer() string {
 	if x != nil && x.Stringer != nil {
 		return *x.Stringer
@@ -137,6 +282,273 @@ func (x *Options) GetStringerName() string {
 	return ""
 }
 
+func (x *Options) GetString_() string {
+	if x != nil && x.String_ != nil {
+		return *x.String_
+	}
+	return ""
+}
+
+func (x *Options) GetTextMarshaler() bool {
+	if x != nil && x.TextMarshaler != nil {
+		return *x.TextMarshaler
+	}
+	return false
+}
+
+func (x *Options) GetHelpers() bool {
+	if x != nil && x.Helpers != nil {
+		return *x.Helpers
+	}
+	return false
+}
+
+func (x *Options) GetPreferred() bool {
+	if x != nil && x.Preferred != nil {
+		return *x.Preferred
+	}
+	return false
+}
+
+// FileOptions represent Go-specific options for Protobuf files.
+// The alias, getter, accessors, and tags options are defaults for every applicable element in the file.
+type FileOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The prefix option adds a prefix to the generated Go names of top-level messages and enums.
+	// It is ignored for messages or enums with a name option.
+	Prefix *string `protobuf:"bytes,5,opt,name=prefix" json:"prefix,omitempty"`
+	// The suffix option adds a suffix to the generated Go names of top-level messages and enums.
+	// It is ignored for messages or enums with a name option.
+	Suffix *string `protobuf:"bytes,6,opt,name=suffix" json:"suffix,omitempty"`
+	// The renames option specifies rules to rename generated Go message and enum names.
+	// Rules apply to the name of each message or enum without the names of its parents.
+	// Rules are applied in order before the prefix and suffix options,
+	// and are ignored for messages or enums with a name option.
+	Renames []*Rename `protobuf:"bytes,8,rep,name=renames" json:"renames,omitempty"`
+	// The alias option generates a Go alias with the original name of every renamed message, enum, and enum value in the file.
+	// It can be disabled for a message, enum, or enum value with its alias option.
+	Alias *bool `protobuf:"varint,7,opt,name=alias" json:"alias,omitempty"`
+	// The registry option generates an init function that registers the patched Go names of every
+	// message, field, oneof, enum, enum value, and extension in a file with github.com/alta/protopatch/runtime.
+	Registry *bool `protobuf:"varint,9,opt,name=registry" json:"registry,omitempty"`
+	// The getter option replaces the Get prefix of every getter method in the file.
+	// A getter option specified on a field or oneof takes precedence.
+	Getter *string `protobuf:"bytes,10,opt,name=getter" json:"getter,omitempty"`
+	// The accessors option generates Get<Extension> and Set<Extension> functions for every extension in the file.
+	// An accessors option specified on an extension takes precedence.
+	Accessors *bool `protobuf:"varint,13,opt,name=accessors" json:"accessors,omitempty"`
+	// The tags option specifies additional struct tags which are appended to every generated Go struct field in the file.
+	// Tags specified on a field take precedence.
+	// The value should omit the enclosing backticks.
+	Tags *string `protobuf:"bytes,20,opt,name=tags" json:"tags,omitempty"`
+}
+
+func (x *FileOptions) Reset() {
+	*x = FileOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_patch_go_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileOptions) ProtoMessage() {}
+
+func (x *FileOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_patch_go_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileOptions.ProtoReflect.Descriptor instead.
+func (*FileOptions) Descriptor() ([]byte, []int) {
+	return file_patch_go_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FileOptions) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
+	}
+	return ""
+}
+
+func (x *FileOptions) GetSuffix() string {
+	if x != nil && x.Suffix != nil {
+		return *x.Suffix
+	}
+	return ""
+}
+
+func (x *FileOptions) GetRenames() []*Rename {
+	if x != nil {
+		return x.Renames
+	}
+	return nil
+}
+
+func (x *FileOptions) GetAlias() bool {
+	if x != nil && x.Alias != nil {
+		return *x.Alias
+	}
+	return false
+}
+
+func (x *FileOptions) GetRegistry() bool {
+	if x != nil && x.Registry != nil {
+		return *x.Registry
+	}
+	return false
+}
+
+func (x *FileOptions) GetGetter() string {
+	if x != nil && x.Getter != nil {
+		return *x.Getter
+	}
+	return ""
+}
+
+func (x *FileOptions) GetAccessors() bool {
+	if x != nil && x.Accessors != nil {
+		return *x.Accessors
+	}
+	return false
+}
+
+func (x *FileOptions) GetTags() string {
+	if x != nil && x.Tags != nil {
+		return *x.Tags
+	}
+	return ""
+}
+
+// Rename represents a rule to rename generated Go names.
+type Rename struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Set messages to true if the rule applies to message names.
+	Messages *bool `protobuf:"varint,1,opt,name=messages" json:"messages,omitempty"`
+	// Set enums to true if the rule applies to enum names.
+	Enums *bool `protobuf:"varint,2,opt,name=enums" json:"enums,omitempty"`
+	// The trim_prefix option removes a prefix from matching names.
+	TrimPrefix *string `protobuf:"bytes,10,opt,name=trim_prefix,json=trimPrefix" json:"trim_prefix,omitempty"`
+	// The trim_suffix option removes a suffix from matching names.
+	TrimSuffix *string `protobuf:"bytes,11,opt,name=trim_suffix,json=trimSuffix" json:"trim_suffix,omitempty"`
+	// The prefix option adds a prefix to matching names.
+	Prefix *string `protobuf:"bytes,12,opt,name=prefix" json:"prefix,omitempty"`
+	// The suffix option adds a suffix to matching names.
+	Suffix *string `protobuf:"bytes,13,opt,name=suffix" json:"suffix,omitempty"`
+	// The pattern option is a regular expression, which restricts the rule to matching names.
+	// Examples: ^(.*)Request$, V1$
+	Pattern *string `protobuf:"bytes,20,opt,name=pattern" json:"pattern,omitempty"`
+	// The replace option replaces matches of pattern in a name.
+	// The value may refer to submatches of pattern, e.g. ${1}Req.
+	// If replace is not set, names matching pattern are not replaced.
+	Replace *string `protobuf:"bytes,21,opt,name=replace" json:"replace,omitempty"`
+}
+
+func (x *Rename) Reset() {
+	*x = Rename{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_patch_go_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rename) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rename) ProtoMessage() {}
+
+func (x *Rename) ProtoReflect() protoreflect.Message {
+	mi := &file_patch_go_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rename.ProtoReflect.Descriptor instead.
+func (*Rename) Descriptor() ([]byte, []int) {
+	return file_patch_go_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Rename) GetMessages() bool {
+	if x != nil && x.Messages != nil {
+		return *x.Messages
+	}
+	return false
+}
+
+func (x *Rename) GetEnums() bool {
+	if x != nil && x.Enums != nil {
+		return *x.Enums
+	}
+	return false
+}
+
+func (x *Rename) GetTrimPrefix() string {
+	if x != nil && x.TrimPrefix != nil {
+		return *x.TrimPrefix
+	}
+	return ""
+}
+
+func (x *Rename) GetTrimSuffix() string {
+	if x != nil && x.TrimSuffix != nil {
+		return *x.TrimSuffix
+	}
+	return ""
+}
+
+func (x *Rename) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
+	}
+	return ""
+}
+
+func (x *Rename) GetSuffix() string {
+	if x != nil && x.Suffix != nil {
+		return *x.Suffix
+	}
+	return ""
+}
+
+func (x *Rename) GetPattern() string {
+	if x != nil && x.Pattern != nil {
+		return *x.Pattern
+	}
+	return ""
+}
+
+func (x *Rename) GetReplace() string {
+	if x != nil && x.Replace != nil {
+		return *x.Replace
+	}
+	return ""
+}
+
 // LintOptions represent options for linting a generated Go file.
 type LintOptions struct {
 	state         protoimpl.MessageState
@@ -159,6 +571,10 @@ type LintOptions struct {
 	Values *bool `protobuf:"varint,5,opt,name=values" json:"values,omitempty"`
 	// Set extensions to true if generated extension names should be linted.
 	Extensions *bool `protobuf:"varint,6,opt,name=extensions" json:"extensions,omitempty"`
+	// Set oneof_wrappers to true if generated oneof wrapper types and interfaces
+	// should be named without an underscore, e.g. MessageField instead of Message_Field.
+	// This option is not implied by all, as it changes the names of existing wrapper types.
+	OneofWrappers *bool `protobuf:"varint,7,opt,name=oneof_wrappers,json=oneofWrappers" json:"oneof_wrappers,omitempty"`
 	// The initialisms option lets you specify strings that should not be generated as mixed-case,
 	// Examples: ID, URL, HTTP, etc.
 	Initialisms []string `protobuf:"bytes,10,rep,name=initialisms" json:"initialisms,omitempty"`
@@ -167,7 +583,7 @@ type LintOptions struct {
 func (x *LintOptions) Reset() {
 	*x = LintOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_patch_go_proto_msgTypes[1]
+		mi := &file_patch_go_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -180,7 +596,7 @@ func (x *LintOptions) String() string {
 func (*LintOptions) ProtoMessage() {}
 
 func (x *LintOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_patch_go_proto_msgTypes[1]
+	mi := &file_patch_go_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -193,7 +609,7 @@ func (x *LintOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LintOptions.ProtoReflect.Descriptor instead.
 func (*LintOptions) Descriptor() ([]byte, []int) {
-	return file_patch_go_proto_rawDescGZIP(), []int{1}
+	return file_patch_go_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *LintOptions) GetAll() bool {
@@ -238,6 +654,13 @@ func (x *LintOptions) GetExtensions() bool {
 	return false
 }
 
+func (x *LintOptions) GetOneofWrappers() bool {
+	if x != nil && x.OneofWrappers != nil {
+		return *x.OneofWrappers
+	}
+	return false
+}
+
 func (x *LintOptions) GetInitialisms() []string {
 	if x != nil {
 		return x.Initialisms
@@ -246,6 +669,14 @@ func (x *LintOptions) GetInitialisms() []string {
 }
 
 var file_patch_go_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*FileOptions)(nil),
+		Field:         7002,
+		Name:          "go.file",
+		Tag:           "bytes,7002,opt,name=file",
+		Filename:      "patch/go.proto",
+	},
 	{
 		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
 		ExtensionType: (*Options)(nil),
@@ -296,40 +727,42 @@ var file_patch_go_proto_extTypes = []protoimpl.ExtensionInfo{
 	},
 }
 
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// optional go.FileOptions file = 7002;
+	E_File = &file_patch_go_proto_extTypes[0]
+	// optional go.LintOptions lint = 7001;
+	E_Lint = &file_patch_go_proto_extTypes[6]
+)
+
 // Extension fields to descriptorpb.MessageOptions.
 var (
 	// optional go.Options message = 7001;
-	E_Message = &file_patch_go_proto_extTypes[0]
+	E_Message = &file_patch_go_proto_extTypes[1]
 )
 
 // Extension fields to descriptorpb.FieldOptions.
 var (
 	// optional go.Options field = 7001;
-	E_Field = &file_patch_go_proto_extTypes[1]
+	E_Field = &file_patch_go_proto_extTypes[2]
 )
 
 // Extension fields to descriptorpb.OneofOptions.
 var (
 	// optional go.Options oneof = 7001;
-	E_Oneof = &file_patch_go_proto_extTypes[2]
+	E_Oneof = &file_patch_go_proto_extTypes[3]
 )
 
 // Extension fields to descriptorpb.EnumOptions.
 var (
 	// optional go.Options enum = 7001;
-	E_Enum = &file_patch_go_proto_extTypes[3]
+	E_Enum = &file_patch_go_proto_extTypes[4]
 )
 
 // Extension fields to descriptorpb.EnumValueOptions.
 var (
 	// optional go.Options value = 7001;
-	E_Value = &file_patch_go_proto_extTypes[4]
-)
-
-// Extension fields to descriptorpb.FileOptions.
-var (
-	// optional go.LintOptions lint = 7001;
-	E_Lint = &file_patch_go_proto_extTypes[5]
+	E_Value = &file_patch_go_proto_extTypes[5]
 )
 
 var File_patch_go_proto protoreflect.FileDescriptor
@@ -338,59 +771,123 @@ var file_patch_go_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x02, 0x67, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x01, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x05, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x67, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc3, 0x01,
-	0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a,
-	0x03, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12,
-	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x73, 0x6d, 0x73,
-	0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69,
-	0x73, 0x6d, 0x73, 0x3a, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0xd9, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x2e, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x41, 0x0a, 0x05,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd9, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f,
-	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a,
-	0x41, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65,
+	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6c, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x32, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x6f, 0x63, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x5f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x3d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x2d,
+	0x0a, 0x12, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6d,
+	0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x74, 0x65, 0x78, 0x74, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x18, 0x23, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x22, 0xdf, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x24, 0x0a, 0x07, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x6f, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x52, 0x07, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x67, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65,
+	0x6e, 0x75, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x69, 0x6d, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x73, 0x75,
+	0x66, 0x66, 0x69, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x69, 0x6d,
+	0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0xea, 0x01, 0x0a, 0x0b, 0x4c,
+	0x69, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x57, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x69, 0x73, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x69, 0x73, 0x6d, 0x73, 0x3a, 0x42, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xda, 0x36,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x47, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd9, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x67, 0x6f, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x6f, 0x6e, 0x65,
-	0x6f, 0x66, 0x3a, 0x3e, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75,
-	0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd9, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x67, 0x6f, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x65, 0x6e,
-	0x75, 0x6d, 0x3a, 0x45, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e,
-	0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd9,
-	0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x42, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
-	0x74, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0xd9, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x2e, 0x4c, 0x69, 0x6e, 0x74,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x74, 0x42, 0x27, 0x5a,
-	0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x70, 0x61, 0x74, 0x63,
-	0x68, 0x2f, 0x67, 0x6f, 0x70, 0x62,
+	0x2e, 0x67, 0x6f, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x3a, 0x41, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd9, 0x36, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x41, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0xd9, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x2e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3a, 0x3e, 0x0a, 0x04, 0x65, 0x6e,
+	0x75, 0x6d, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0xd9, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x3a, 0x45, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd9, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x67, 0x6f, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x42, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd9, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x67, 0x6f, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x74, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x2f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x70, 0x62,
 }
 
 var (
@@ -405,35 +902,40 @@ func file_patch_go_proto_rawDescGZIP() []byte {
 	return file_patch_go_proto_rawDescData
 }
 
-var file_patch_go_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_patch_go_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_patch_go_proto_goTypes = []any{
 	(*Options)(nil),                       // 0: go.Options
-	(*LintOptions)(nil),                   // 1: go.LintOptions
-	(*descriptorpb.MessageOptions)(nil),   // 2: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),     // 3: google.protobuf.FieldOptions
-	(*descriptorpb.OneofOptions)(nil),     // 4: google.protobuf.OneofOptions
-	(*descriptorpb.EnumOptions)(nil),      // 5: google.protobuf.EnumOptions
-	(*descriptorpb.EnumValueOptions)(nil), // 6: google.protobuf.EnumValueOptions
-	(*descriptorpb.FileOptions)(nil),      // 7: google.protobuf.FileOptions
+	(*FileOptions)(nil),                   // 1: go.FileOptions
+	(*Rename)(nil),                        // 2: go.Rename
+	(*LintOptions)(nil),                   // 3: go.LintOptions
+	(*descriptorpb.FileOptions)(nil),      // 4: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil),   // 5: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),     // 6: google.protobuf.FieldOptions
+	(*descriptorpb.OneofOptions)(nil),     // 7: google.protobuf.OneofOptions
+	(*descriptorpb.EnumOptions)(nil),      // 8: google.protobuf.EnumOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 9: google.protobuf.EnumValueOptions
 }
 var file_patch_go_proto_depIdxs = []int32{
-	2,  // 0: go.message:extendee -> google.protobuf.MessageOptions
-	3,  // 1: go.field:extendee -> google.protobuf.FieldOptions
-	4,  // 2: go.oneof:extendee -> google.protobuf.OneofOptions
-	5,  // 3: go.enum:extendee -> google.protobuf.EnumOptions
-	6,  // 4: go.value:extendee -> google.protobuf.EnumValueOptions
-	7,  // 5: go.lint:extendee -> google.protobuf.FileOptions
-	0,  // 6: go.message:type_name -> go.Options
-	0,  // 7: go.field:type_name -> go.Options
-	0,  // 8: go.oneof:type_name -> go.Options
-	0,  // 9: go.enum:type_name -> go.Options
-	0,  // 10: go.value:type_name -> go.Options
-	1,  // 11: go.lint:type_name -> go.LintOptions
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	6,  // [6:12] is the sub-list for extension type_name
-	0,  // [0:6] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	2,  // 0: go.FileOptions.renames:type_name -> go.Rename
+	4,  // 1: go.file:extendee -> google.protobuf.FileOptions
+	5,  // 2: go.message:extendee -> google.protobuf.MessageOptions
+	6,  // 3: go.field:extendee -> google.protobuf.FieldOptions
+	7,  // 4: go.oneof:extendee -> google.protobuf.OneofOptions
+	8,  // 5: go.enum:extendee -> google.protobuf.EnumOptions
+	9,  // 6: go.value:extendee -> google.protobuf.EnumValueOptions
+	4,  // 7: go.lint:extendee -> google.protobuf.FileOptions
+	1,  // 8: go.file:type_name -> go.FileOptions
+	0,  // 9: go.message:type_name -> go.Options
+	0,  // 10: go.field:type_name -> go.Options
+	0,  // 11: go.oneof:type_name -> go.Options
+	0,  // 12: go.enum:type_name -> go.Options
+	0,  // 13: go.value:type_name -> go.Options
+	3,  // 14: go.lint:type_name -> go.LintOptions
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	8,  // [8:15] is the sub-list for extension type_name
+	1,  // [1:8] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_patch_go_proto_init() }
@@ -455,6 +957,30 @@ func file_patch_go_proto_init() {
 			}
 		}
 		file_patch_go_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*FileOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_patch_go_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Rename); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_patch_go_proto_msgTypes[3].Exporter = func(v any, i int) any {
 			switch v := v.(*LintOptions); i {
 			case 0:
 				return &v.state
@@ -473,8 +999,8 @@ func file_patch_go_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_patch_go_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
-			NumExtensions: 6,
+			NumMessages:   4,
+			NumExtensions: 7,
 			NumServices:   0,
 		},
 		GoTypes:           file_patch_go_proto_goTypes,
@@ -0,0 +1,271 @@
+package patch
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// generateImplements generates a compile-time assertion for each interface
+// specified with the implements option on a message in f, adding imports to gf as needed.
+func (p *Patcher) generateImplements(b *bytes.Buffer, f *protogen.File, gf *ast.File) {
+	walkMessages(f.Messages, func(m *protogen.Message) {
+		for _, iface := range messageOptions(m).GetImplements() {
+			path, name := splitQualifiedName(iface)
+			if !token.IsIdentifier(name) {
+				p.warn("invalid implements option", "message", m.Desc.FullName(), "implements", iface)
+				continue
+			}
+			if path != "" && path != string(f.GoImportPath) {
+				name = p.importName(gf, path) + "." + name
+			}
+			fmt.Fprintf(b, "var _ %s = (*%s)(nil)\n\n", name, p.nameFor(m.GoIdent))
+		}
+	})
+}
+
+// verifyImplements verifies the method sets of messages with the implements option
+// against interfaces found in export data. It must be called after all declarations are generated.
+func (p *Patcher) verifyImplements() {
+	if p.exports == nil {
+		return
+	}
+	pkgs := make(map[protogen.GoImportPath]*checkedPackage)
+	for _, f := range p.gen.Files {
+		if !f.Generate || p.filesByName[p.goFilename(f, ".pb.go")] == nil {
+			continue
+		}
+		walkMessages(f.Messages, func(m *protogen.Message) {
+			for _, iface := range messageOptions(m).GetImplements() {
+				path, name := splitQualifiedName(iface)
+				if !token.IsIdentifier(name) {
+					continue
+				}
+				if path == "" {
+					path = string(f.GoImportPath)
+				}
+				pkg, ok := pkgs[f.GoImportPath]
+				if !ok {
+					pkg = p.checkPatchedPackage(f.GoImportPath)
+					pkgs[f.GoImportPath] = pkg
+				}
+				if pkg != nil {
+					p.verifyImplement(pkg, m, path, name)
+				}
+			}
+		})
+	}
+}
+
+// walkMessages calls fn for each message in messages and their nested messages.
+func walkMessages(messages []*protogen.Message, fn func(*protogen.Message)) {
+	for _, m := range messages {
+		fn(m)
+		walkMessages(m.Messages, fn)
+	}
+}
+
+// splitQualifiedName splits a qualified Go name, e.g. "github.com/acme/domain.Entity",
+// into an import path and name. The import path is empty for an unqualified name.
+func splitQualifiedName(s string) (path, name string) {
+	i := strings.LastIndex(s, ".")
+	if i < strings.LastIndex(s, "/") {
+		return "", s
+	}
+	return s[:max(i, 0)], s[i+1:]
+}
+
+// verifyImplement warns if the patched Go type for m in pkg is missing any method of the interface path.name,
+// or declares a method of the interface with a different signature.
+// Signatures are only verified if pkg type-checked without errors outside the generated interface assertions.
+// Verification is skipped if the interface package cannot be loaded, or the interface is not found in export data or pkg.
+func (p *Patcher) verifyImplement(checked *checkedPackage, m *protogen.Message, path, name string) {
+	pkg := checked.pkg
+	ifacePkg := pkg
+	if path != pkg.Path() {
+		if err := p.exports.Load([]string{path}); err != nil {
+			p.log.Debug("unable to load package", "path", path, "error", err)
+			return
+		}
+		var err error
+		ifacePkg, err = p.exports.Import(path)
+		if err != nil {
+			p.log.Debug("unable to import package", "path", path, "error", err)
+			return
+		}
+		if ifacePkg == nil {
+			p.log.Debug("interface not found", "path", path, "name", name)
+			return
+		}
+	}
+	tn, ok := ifacePkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok || !types.IsInterface(tn.Type()) {
+		p.warn("interface not found", "message", m.Desc.FullName(), "implements", path+"."+name)
+		return
+	}
+	typ, ok := pkg.Scope().Lookup(p.nameFor(m.GoIdent)).(*types.TypeName)
+	if !ok {
+		p.warn("unable to find type for message", "message", m.Desc.FullName(), "type", p.nameFor(m.GoIdent))
+		return
+	}
+
+	var missing []string
+	iface := tn.Type().Underlying().(*types.Interface)
+	ptr := types.NewPointer(typ.Type())
+	for i := 0; i < iface.NumMethods(); i++ {
+		want := iface.Method(i)
+		obj, _, _ := types.LookupFieldOrMethod(ptr, false, want.Pkg(), want.Name())
+		fn, ok := obj.(*types.Func)
+		if !ok {
+			missing = append(missing, want.Name())
+			continue
+		}
+		if checked.valid && !types.Identical(fn.Type(), want.Type()) {
+			p.warn("message method has wrong signature for interface", "message", m.Desc.FullName(), "implements", path+"."+name,
+				"method", want.Name(), "have", signatureString(fn, pkg), "want", signatureString(want, pkg))
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		p.warn("message does not implement interface", "message", m.Desc.FullName(), "implements", path+"."+name, "missing", strings.Join(missing, ","))
+	}
+}
+
+// signatureString returns the signature of fn without its receiver, qualified relative to pkg.
+func signatureString(fn *types.Func, pkg *types.Package) string {
+	sig := fn.Type().(*types.Signature)
+	return "func" + strings.TrimPrefix(types.TypeString(types.NewSignatureType(nil, nil, nil, sig.Params(), sig.Results(), sig.Variadic()), relativeQualifier(pkg)), "func")
+}
+
+// checkedPackage is a Go package type-checked by checkPatchedPackage.
+type checkedPackage struct {
+	pkg   *types.Package
+	valid bool // No type errors, except in generated interface assertions
+}
+
+// checkPatchedPackage type-checks the Go package with import path as it will be written:
+// the patched Go files and generated declarations for the proto files being generated,
+// and any other Go files in the package found by golang.org/x/tools/go/packages.
+// Imports are resolved from export data. It returns nil if the package cannot be type-checked.
+func (p *Patcher) checkPatchedPackage(path protogen.GoImportPath) *checkedPackage {
+	if err := p.exports.Load([]string{string(path)}); err != nil {
+		p.log.Debug("unable to load package", "path", path, "error", err)
+		return nil
+	}
+	fset := token.NewFileSet()
+	var files []*ast.File
+	var assertions []ast.Node
+	generated := make(map[string]bool)
+	for _, f := range p.gen.Files {
+		filename := p.goFilename(f, ".pb.go")
+		gf := p.filesByName[filename]
+		if !f.Generate || f.GoImportPath != path || gf == nil {
+			continue
+		}
+		generated[filepath.Base(filename)] = true
+		var b bytes.Buffer
+		if err := format.Node(&b, p.fset, gf); err != nil {
+			p.log.Debug("unable to format patched file", "file", filename, "error", err)
+			return nil
+		}
+		b.WriteString("\n")
+		b.Write(p.decls[filename])
+		file, err := parser.ParseFile(fset, filename, b.Bytes(), parser.SkipObjectResolution)
+		if err != nil {
+			p.log.Debug("unable to parse patched file", "file", filename, "error", err)
+			return nil
+		}
+		files = append(files, file)
+		assertions = append(assertions, generatedAssertions(file, file.FileStart+token.Pos(b.Len()-len(p.decls[filename])))...)
+	}
+	for _, filename := range p.exports.GoFiles(string(path)) {
+		if generated[filepath.Base(filename)] {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filename, nil, parser.SkipObjectResolution)
+		if err != nil {
+			p.log.Debug("unable to parse file", "file", filename, "error", err)
+			continue
+		}
+		files = append(files, file)
+	}
+
+	var imports []string
+	for _, file := range files {
+		for _, spec := range file.Imports {
+			if importPath, err := strconv.Unquote(spec.Path.Value); err == nil {
+				imports = append(imports, importPath)
+			}
+		}
+	}
+	if err := p.exports.Load(imports); err != nil {
+		p.log.Debug("unable to load packages", "path", path, "error", err)
+		return nil
+	}
+	valid := true
+	conf := types.Config{
+		Importer: importerFunc(func(path string) (*types.Package, error) {
+			pkg, err := p.exports.Import(path)
+			if pkg == nil && err == nil {
+				err = fmt.Errorf("no export data for %s", path)
+			}
+			return pkg, err
+		}),
+		Error: func(err error) {
+			// Assertions for interfaces that are not implemented are reported by verifyImplement.
+			if terr, ok := err.(types.Error); ok && containsPos(assertions, terr.Pos) {
+				return
+			}
+			p.log.Debug("type error in patched package", "path", path, "error", err)
+			valid = false
+		},
+	}
+	pkg, _ := conf.Check(string(path), fset, files, nil)
+	return &checkedPackage{pkg: pkg, valid: valid}
+}
+
+// generatedAssertions returns the compile-time interface assertions generated by generateImplements
+// at or after pos in f.
+func generatedAssertions(f *ast.File, pos token.Pos) []ast.Node {
+	var nodes []ast.Node
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.VAR || gd.Pos() < pos {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			if vs, ok := spec.(*ast.ValueSpec); ok && len(vs.Names) == 1 && vs.Names[0].Name == "_" {
+				nodes = append(nodes, vs)
+			}
+		}
+	}
+	return nodes
+}
+
+// containsPos reports whether pos is within any of nodes.
+func containsPos(nodes []ast.Node, pos token.Pos) bool {
+	for _, n := range nodes {
+		if n.Pos() <= pos && pos < n.End() {
+			return true
+		}
+	}
+	return false
+}
+
+// importerFunc implements the types.Importer interface with a function.
+type importerFunc func(path string) (*types.Package, error)
+
+// Import implements the types.Importer interface.
+func (fn importerFunc) Import(path string) (*types.Package, error) {
+	return fn(path)
+}
@@ -1,6 +1,50 @@
 package patch
 
-import "go/types"
+import (
+	"fmt"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"sync"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// ImportMode determines how a Patcher resolves imports of Go packages that it is not patching.
+type ImportMode int
+
+const (
+	// ImportStub resolves imported Go packages to empty stub packages.
+	ImportStub ImportMode = iota
+
+	// ImportPackages resolves imported Go packages from Go export data, located with golang.org/x/tools/go/packages.
+	// Packages that cannot be loaded are resolved to empty stub packages.
+	ImportPackages
+)
+
+// String implements the fmt.Stringer interface.
+func (mode ImportMode) String() string {
+	switch mode {
+	case ImportStub:
+		return "stub"
+	case ImportPackages:
+		return "packages"
+	}
+	return fmt.Sprintf("ImportMode(%d)", int(mode))
+}
+
+// ParseImportMode parses s into an ImportMode.
+func ParseImportMode(s string) (ImportMode, error) {
+	switch s {
+	case "", "stub":
+		return ImportStub, nil
+	case "packages":
+		return ImportPackages, nil
+	}
+	return ImportStub, fmt.Errorf("unknown import mode: %q", s)
+}
 
 type basicImporter struct {
 	p *Patcher
@@ -12,7 +56,110 @@ func (i basicImporter) Import(path string) (*types.Package, error) {
 }
 
 // ImportFrom implements the types.ImporterFrom interface.
+// Packages being patched take precedence over packages loaded from export data.
+// Packages that cannot be imported from export data are stubbed.
 func (i basicImporter) ImportFrom(path, dir string, mode types.ImportMode) (*types.Package, error) {
+	if pkg := i.p.getPackage(path, "", false); pkg != nil && len(pkg.files) > 0 {
+		return pkg.pkg, nil
+	}
+	if i.p.exports != nil {
+		pkg, err := i.p.exports.Import(path)
+		if err != nil {
+			i.p.log.Debug("unable to import package", "path", path, "error", err)
+		}
+		if pkg != nil {
+			return pkg, nil
+		}
+	}
 	pkg := i.p.getPackage(path, "", true)
 	return pkg.pkg, nil
 }
+
+// exportImporter imports Go packages from Go export data.
+// It is safe for concurrent use.
+type exportImporter struct {
+	mu       sync.Mutex
+	importer types.Importer
+	files    map[string]string         // Export data files by import path
+	goFiles  map[string][]string       // Go source files by import path
+	loaded   map[string]bool           // Import paths passed to Load
+	pkgs     map[string]*types.Package // Imported packages by path; nil if import failed
+}
+
+func newExportImporter(fset *token.FileSet) *exportImporter {
+	imp := &exportImporter{
+		files:   make(map[string]string),
+		goFiles: make(map[string][]string),
+		loaded:  make(map[string]bool),
+		pkgs:    make(map[string]*types.Package),
+	}
+	imp.importer = importer.ForCompiler(fset, "gc", imp.lookup)
+	return imp
+}
+
+// Load locates export data for paths and their dependencies.
+// Paths that have already been loaded are skipped.
+func (imp *exportImporter) Load(paths []string) error {
+	imp.mu.Lock()
+	defer imp.mu.Unlock()
+	var patterns []string
+	for _, path := range paths {
+		if !imp.loaded[path] {
+			imp.loaded[path] = true
+			patterns = append(patterns, path)
+		}
+	}
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedExportFile | packages.NeedImports | packages.NeedDeps,
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return err
+	}
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		if pkg.ExportFile != "" {
+			imp.files[pkg.PkgPath] = pkg.ExportFile
+		}
+		imp.goFiles[pkg.PkgPath] = pkg.GoFiles
+	})
+	return nil
+}
+
+// Import returns the package with path from export data, or nil if no export data was found.
+// An error is returned the first time a package with export data cannot be imported.
+func (imp *exportImporter) Import(path string) (*types.Package, error) {
+	imp.mu.Lock()
+	defer imp.mu.Unlock()
+	if pkg, ok := imp.pkgs[path]; ok {
+		return pkg, nil
+	}
+	var pkg *types.Package
+	var err error
+	if _, ok := imp.files[path]; ok {
+		pkg, err = imp.importer.Import(path)
+		if err != nil {
+			pkg = nil
+		}
+	}
+	imp.pkgs[path] = pkg
+	return pkg, err
+}
+
+// GoFiles returns the Go source files of the package with path, if loaded.
+func (imp *exportImporter) GoFiles(path string) []string {
+	imp.mu.Lock()
+	defer imp.mu.Unlock()
+	return imp.goFiles[path]
+}
+
+func (imp *exportImporter) lookup(path string) (io.ReadCloser, error) {
+	filename, ok := imp.files[path]
+	if !ok {
+		return nil, fmt.Errorf("no export data for %s", path)
+	}
+	return os.Open(filename)
+}
@@ -0,0 +1,150 @@
+package patch
+
+import (
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/alta/protopatch/patch/gopb"
+	"github.com/alta/protopatch/tests/message"
+)
+
+func TestImportPackages(t *testing.T) {
+	p, err := NewPatcher(&protogen.Plugin{}, WithImportMode(ImportPackages))
+	if err != nil {
+		t.Fatal(err)
+	}
+	const (
+		protoimpl = "google.golang.org/protobuf/runtime/protoimpl"
+		missing   = "example.com/missing/package"
+	)
+	if err := p.exports.Load([]string{protoimpl, missing}); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg, err := basicImporter{p}.Import(protoimpl)
+	assert.NoError(t, err)
+	assert.True(t, pkg.Complete())
+	assert.NotNil(t, pkg.Scope().Lookup("MessageState"))
+
+	pkg, err = basicImporter{p}.Import(missing)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, pkg.Scope().Len())
+	assert.Same(t, p.getPackage(missing, "", false).pkg, pkg)
+
+	// Unreadable export data is stubbed without a warning, even in strict mode.
+	const unreadable = "example.com/unreadable/package"
+	p.strict = true
+	p.exports.files[unreadable] = "/nonexistent/export/data"
+	pkg, err = basicImporter{p}.Import(unreadable)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, pkg.Scope().Len())
+	assert.Empty(t, p.warnings)
+}
+
+func TestImportPackagesPatch(t *testing.T) {
+	req := testRequest("paths=import", message.File_tests_message_message_field_types_proto)
+	want := testPatch(t, req)
+	got := testPatch(t, req, WithImportMode(ImportPackages))
+	if !assert.Equal(t, len(want.File), len(got.File)) {
+		return
+	}
+	for i := range want.File {
+		assert.Equal(t, want.File[i].GetContent(), got.File[i].GetContent(), want.File[i].GetName())
+	}
+}
+
+func TestVerifyFieldTypes(t *testing.T) {
+	p, err := NewPatcher(&protogen.Plugin{}, WithImportMode(ImportPackages))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := types.NewPackage("github.com/alta/protopatch/tests/message", "message")
+	field := func(name string, typ types.Type) types.Object {
+		return types.NewField(token.NoPos, pkg, name, typ, false)
+	}
+	getter := func(name string, typ types.Type) types.Object {
+		results := types.NewTuple(types.NewVar(token.NoPos, pkg, "", typ))
+		return types.NewFunc(token.NoPos, pkg, name, types.NewSignatureType(nil, nil, nil, nil, results, false))
+	}
+	tests := []struct {
+		obj       types.Object
+		fieldType string
+		err       bool
+	}{
+		{field("StringField", types.Typ[types.String]), "String", false},
+		{field("OptionalInt64Field", types.NewPointer(types.Typ[types.Int64])), "Int64", false},
+		{field("RepeatedStringField", types.NewSlice(types.Typ[types.String])), "Strings", false},
+		{getter("GetStringField", types.Typ[types.String]), "String", false},
+		{field("Int32Field", types.Typ[types.String]), "Int32", true},
+		{field("StringsField", types.Typ[types.String]), "Strings", true},
+		{getter("GetInt32Field", types.Typ[types.String]), "Int32", true},
+		{field("UnknownField", types.Typ[types.String]), "Unknown", false},
+		{field("QualifiedField", types.Typ[types.String]), "time.Duration", false},
+	}
+	if err := p.exports.Load([]string{pkg.Path()}); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		p.fieldTypes = map[types.Object]string{tt.obj: tt.fieldType}
+		errs := p.verifyFieldTypes()
+		if tt.err {
+			assert.Len(t, errs, 1, tt.obj.Name())
+		} else {
+			assert.Empty(t, errs, tt.obj.Name())
+		}
+	}
+}
+
+func TestVerifyImplements(t *testing.T) {
+	req := testRequest("paths=import", message.File_tests_message_message_implements_proto)
+	res := testPatch(t, req, WithImportMode(ImportPackages), WithStrict(true))
+	assert.Nil(t, res.Error)
+
+	tests := []struct {
+		name       string
+		implements string
+		want       string
+	}{
+		{
+			"missing method",
+			"io.Reader",
+			"protopatch: message does not implement interface message=tests.message.Entity implements=io.Reader missing=Read",
+		},
+		{
+			"wrong signature",
+			"Resetter",
+			"protopatch: message method has wrong signature for interface message=tests.message.Entity implements=github.com/alta/protopatch/tests/message.Resetter method=Reset have=func() want=func() error",
+		},
+		{
+			"wrong signature and missing methods",
+			"google.golang.org/protobuf/reflect/protoreflect.Enum",
+			"protopatch: message does not implement interface message=tests.message.Entity implements=google.golang.org/protobuf/reflect/protoreflect.Enum missing=Number,Type\n" +
+				"protopatch: message method has wrong signature for interface message=tests.message.Entity implements=google.golang.org/protobuf/reflect/protoreflect.Enum method=Descriptor have=func() ([]byte, []int) want=func() protoreflect.EnumDescriptor",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testRequest("paths=import", message.File_tests_message_message_implements_proto)
+			for _, fd := range req.ProtoFile {
+				if fd.GetName() != message.File_tests_message_message_implements_proto.Path() {
+					continue
+				}
+				opts := proto.Clone(fd.MessageType[0].Options).(*descriptorpb.MessageOptions)
+				mOpts := proto.GetExtension(opts, gopb.E_Message).(*gopb.Options)
+				mOpts.Implements = append(mOpts.Implements, tt.implements)
+				proto.SetExtension(opts, gopb.E_Message, mOpts)
+				fd.MessageType[0].Options = opts
+			}
+			res := testPatch(t, req, WithImportMode(ImportPackages), WithStrict(true))
+			if assert.NotNil(t, res.Error) {
+				assert.Equal(t, tt.want, res.GetError())
+			}
+		})
+	}
+}
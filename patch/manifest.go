@@ -0,0 +1,187 @@
+package patch
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/alta/protopatch/patch/ident"
+)
+
+// Manifest maps the elements declared in a proto file to their patched Go identifiers.
+type Manifest struct {
+	File          string             `json:"file"`
+	GoImportPath  string             `json:"go_import_path"`
+	GoPackageName string             `json:"go_package_name"`
+	Messages      []*ManifestMessage `json:"messages,omitempty"`
+	Enums         []*ManifestEnum    `json:"enums,omitempty"`
+	Extensions    []*ManifestField   `json:"extensions,omitempty"`
+}
+
+// ManifestMessage maps a proto message to its Go struct type.
+// Nested messages are listed separately in Manifest.Messages.
+type ManifestMessage struct {
+	FullName string           `json:"full_name"`
+	GoName   string           `json:"go_name"`
+	Fields   []*ManifestField `json:"fields,omitempty"`
+	Oneofs   []*ManifestOneof `json:"oneofs,omitempty"`
+}
+
+// ManifestField maps a proto message field or extension to its Go identifiers.
+// GoName is the name of the struct field, or the var name of an extension.
+// For oneof fields, GoName is the name of the field in the Wrapper type.
+type ManifestField struct {
+	FullName string `json:"full_name"`
+	GoName   string `json:"go_name"`
+	Getter   string `json:"getter,omitempty"`
+	Embedded bool   `json:"embedded,omitempty"`
+	Oneof    string `json:"oneof,omitempty"`
+	Wrapper  string `json:"wrapper,omitempty"`
+}
+
+// ManifestOneof maps a proto oneof to its Go struct field, getter, and interface type.
+type ManifestOneof struct {
+	FullName  string `json:"full_name"`
+	GoName    string `json:"go_name"`
+	Getter    string `json:"getter"`
+	Interface string `json:"interface"`
+}
+
+// ManifestEnum maps a proto enum to its Go type and values.
+type ManifestEnum struct {
+	FullName string               `json:"full_name"`
+	GoName   string               `json:"go_name"`
+	Values   []*ManifestEnumValue `json:"values,omitempty"`
+}
+
+// ManifestEnumValue maps a proto enum value to its Go const.
+type ManifestEnumValue struct {
+	FullName string `json:"full_name"`
+	GoName   string `json:"go_name"`
+	Number   int32  `json:"number"`
+}
+
+// WithManifest enables generating a manifest for each proto file, named <file>.protopatch.json.
+// See Manifest.
+func WithManifest(manifest bool) Option {
+	return func(p *Patcher) {
+		p.manifest = manifest
+	}
+}
+
+// Manifest returns a Manifest of the patched Go identifiers for proto file f.
+func (p *Patcher) Manifest(f *protogen.File) *Manifest {
+	mf := &Manifest{
+		File:          f.Desc.Path(),
+		GoImportPath:  string(f.GoImportPath),
+		GoPackageName: string(f.GoPackageName),
+	}
+	for _, e := range f.Enums {
+		mf.Enums = append(mf.Enums, p.manifestEnum(e))
+	}
+	for _, m := range f.Messages {
+		p.manifestMessage(mf, m)
+	}
+	for _, x := range f.Extensions {
+		mf.Extensions = append(mf.Extensions, p.manifestExtension(x))
+	}
+	return mf
+}
+
+func (p *Patcher) manifestMessage(mf *Manifest, m *protogen.Message) {
+	if m.Desc.IsMapEntry() {
+		return
+	}
+	mm := &ManifestMessage{
+		FullName: string(m.Desc.FullName()),
+		GoName:   p.nameFor(m.GoIdent),
+	}
+	mf.Messages = append(mf.Messages, mm)
+
+	for _, o := range m.Oneofs {
+		if o.Desc.IsSynthetic() {
+			continue
+		}
+		mm.Oneofs = append(mm.Oneofs, &ManifestOneof{
+			FullName:  string(o.Desc.FullName()),
+			GoName:    p.nameFor(ident.WithChild(m.GoIdent, o.GoName)),
+			Getter:    p.nameFor(ident.WithChild(m.GoIdent, "Get"+o.GoName)),
+			Interface: p.nameFor(ident.WithPrefix(o.GoIdent, "is")),
+		})
+	}
+
+	for _, f := range m.Fields {
+		mfield := &ManifestField{
+			FullName: string(f.Desc.FullName()),
+			Getter:   p.nameFor(ident.WithChild(m.GoIdent, "Get"+f.GoName)),
+		}
+		if f.Oneof != nil && !f.Desc.HasOptionalKeyword() {
+			mfield.GoName = p.nameFor(ident.WithChild(f.GoIdent, f.GoName))
+			mfield.Oneof = string(f.Oneof.Desc.Name())
+			mfield.Wrapper = p.nameFor(f.GoIdent)
+		} else {
+			id := ident.WithChild(m.GoIdent, f.GoName)
+			mfield.GoName = p.nameFor(id)
+			_, mfield.Embedded = p.embeds[id]
+		}
+		mm.Fields = append(mm.Fields, mfield)
+	}
+
+	for _, e := range m.Enums {
+		mf.Enums = append(mf.Enums, p.manifestEnum(e))
+	}
+	for _, mm := range m.Messages {
+		p.manifestMessage(mf, mm)
+	}
+	for _, x := range m.Extensions {
+		mf.Extensions = append(mf.Extensions, p.manifestExtension(x))
+	}
+}
+
+func (p *Patcher) manifestEnum(e *protogen.Enum) *ManifestEnum {
+	me := &ManifestEnum{
+		FullName: string(e.Desc.FullName()),
+		GoName:   p.nameFor(e.GoIdent),
+	}
+	for _, v := range e.Values {
+		me.Values = append(me.Values, &ManifestEnumValue{
+			FullName: string(v.Desc.FullName()),
+			GoName:   p.nameFor(v.GoIdent),
+			Number:   int32(v.Desc.Number()),
+		})
+	}
+	return me
+}
+
+func (p *Patcher) manifestExtension(x *protogen.Extension) *ManifestField {
+	id := x.GoIdent
+	id.GoName = "E_" + x.GoName
+	return &ManifestField{
+		FullName: string(x.Desc.FullName()),
+		GoName:   p.nameFor(id),
+	}
+}
+
+// addManifests adds a manifest to res for each generated proto file with a patched Go file in res.
+func (p *Patcher) addManifests(res *pluginpb.CodeGeneratorResponse) error {
+	names := make(map[string]bool, len(res.File))
+	for _, rf := range res.File {
+		names[rf.GetName()] = true
+	}
+	for _, f := range p.gen.Files {
+		if !f.Generate || !names[p.goFilename(f, ".pb.go")] {
+			continue
+		}
+		b, err := json.MarshalIndent(p.Manifest(f), "", "\t")
+		if err != nil {
+			return err
+		}
+		res.File = append(res.File, &pluginpb.CodeGeneratorResponse_File{
+			Name:    proto.String(p.goFilename(f, ".protopatch.json")),
+			Content: proto.String(string(b) + "\n"),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package patch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/alta/protopatch/tests/enum"
+	"github.com/alta/protopatch/tests/lint"
+	"github.com/alta/protopatch/tests/message"
+)
+
+func TestManifest(t *testing.T) {
+	req := testRequest("paths=import",
+		enum.File_tests_enum_enum_renames_proto,
+		lint.File_tests_lint_lint_oneof_wrappers_proto,
+		message.File_tests_message_message_renames_proto,
+	)
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewPatcher(gen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]*protogen.File)
+	for _, f := range gen.Files {
+		files[f.Desc.Path()] = f
+	}
+
+	mf := p.Manifest(files["tests/enum/enum_renames.proto"])
+	assert.Equal(t, "github.com/alta/protopatch/tests/enum", mf.GoImportPath)
+	assert.Equal(t, "enum", mf.GoPackageName)
+	assert.Equal(t, &ManifestEnum{
+		FullName: "tests.enum.Flavor",
+		GoName:   "Flavour",
+		Values: []*ManifestEnumValue{
+			{"tests.enum.UMAMI", "Flavour_UMAMI", 0},
+			{"tests.enum.SWEET", "Flavour_SWEET", 1},
+			{"tests.enum.SALTY", "Flavour_SALTY", 2},
+			{"tests.enum.SOUR", "Flavour_SOUR", 3},
+			{"tests.enum.BITTER", "Flavour_BITTER", 4},
+		},
+	}, mf.Enums[0])
+	assert.Equal(t, "LevelSimple", mf.Enums[1].Values[0].GoName)
+	assert.Equal(t, "Level_COMPLEX", mf.Enums[1].Values[1].GoName)
+
+	mf = p.Manifest(files["tests/lint/lint_oneof_wrappers.proto"])
+	assert.Equal(t, &ManifestMessage{
+		FullName: "tests.lint.Shape",
+		GoName:   "Figure",
+		Fields: []*ManifestField{
+			{FullName: "tests.lint.Shape.circle", GoName: "Circle", Getter: "GetCircle", Oneof: "contents", Wrapper: "FigureCircle"},
+			{FullName: "tests.lint.Shape.square", GoName: "Box", Getter: "GetBox", Oneof: "contents", Wrapper: "FigureBox"},
+		},
+		Oneofs: []*ManifestOneof{
+			{FullName: "tests.lint.Shape.contents", GoName: "Kind", Getter: "GetKind", Interface: "isFigureKind"},
+		},
+	}, mf.Messages[1])
+	assert.Equal(t, "DogID", mf.Messages[0].Fields[0].GoName)
+	assert.Equal(t, "GetDogID", mf.Messages[0].Fields[0].Getter)
+	assert.Equal(t, "PetDogID", mf.Messages[0].Fields[0].Wrapper)
+
+	mf = p.Manifest(files["tests/message/message_renames.proto"])
+	names := make(map[string]*ManifestMessage)
+	for _, m := range mf.Messages {
+		names[m.FullName] = m
+	}
+	assert.Equal(t, "Frank", names["tests.message.Francis"].GoName)
+	assert.Equal(t, "RenamedOuterMessage_InnerMessage", names["tests.message.OriginalOuterMessage.InnerMessage"].GoName)
+	assert.Equal(t, "RenamedInnerMessage", names["tests.message.OuterMessageWithRenamedInnerMessage.InnerMessage"].GoName)
+	assert.Equal(t, &ManifestField{FullName: "tests.message.MessageWithRenamedField.id", GoName: "ID", Getter: "GetID"}, names["tests.message.MessageWithRenamedField"].Fields[0])
+	assert.Equal(t, &ManifestField{FullName: "tests.message.MessageWithEmbeddedField.embedded_message", GoName: "Embedded", Getter: "GetEmbedded", Embedded: true}, names["tests.message.MessageWithEmbeddedField"].Fields[0])
+	assert.Equal(t, "OptionalString", names["tests.message.MessageWithOptionals"].Fields[0].GoName)
+	assert.Empty(t, names["tests.message.MessageWithOptionals"].Oneofs)
+}
+
+func TestManifestFiles(t *testing.T) {
+	req := testRequest("paths=import", enum.File_tests_enum_enum_renames_proto)
+	res := testPatch(t, req)
+	assert.Len(t, res.File, 1)
+
+	res = testPatch(t, req, WithManifest(true))
+	if !assert.Len(t, res.File, 2) {
+		return
+	}
+	assert.Equal(t, "github.com/alta/protopatch/tests/enum/enum_renames.protopatch.json", res.File[1].GetName())
+	var mf Manifest
+	if err := json.Unmarshal([]byte(res.File[1].GetContent()), &mf); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "tests/enum/enum_renames.proto", mf.File)
+	assert.Equal(t, "Flavour", mf.Enums[0].GoName)
+}
@@ -8,6 +8,10 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+func fileOptions(d protoreflect.Descriptor) *gopb.FileOptions {
+	return proto.GetExtension(d.ParentFile().Options(), gopb.E_File).(*gopb.FileOptions)
+}
+
 func enumOptions(e *protogen.Enum) *gopb.Options {
 	return proto.GetExtension(e.Desc.Options(), gopb.E_Enum).(*gopb.Options)
 }
@@ -5,7 +5,7 @@ import (
 	"go/ast"
 	"go/token"
 	"go/types"
-	"log"
+	"log/slog"
 	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
@@ -14,16 +14,18 @@ import (
 // Package represents a Go package for patching.
 type Package struct {
 	pkg         *types.Package
+	info        *types.Info
 	files       []*ast.File
 	filesByName map[string]*ast.File
+	log         *slog.Logger
 }
 
 // NewPackage returns an initialized Package.
 func NewPackage(path, name string) *Package {
-	log.Printf("Go package:\t%s %q", name, path)
 	return &Package{
 		pkg:         types.NewPackage(path, name),
 		filesByName: make(map[string]*ast.File),
+		log:         slog.Default(),
 	}
 }
 
@@ -45,15 +47,18 @@ func (pkg *Package) AddFile(filename string, f *ast.File) error {
 // Reset resets pkg type-checks.
 func (pkg *Package) Reset() {
 	pkg.pkg = types.NewPackage(pkg.pkg.Path(), pkg.pkg.Name())
+	pkg.info = nil
 }
 
-// Check type-checks pkg.
+// Check type-checks pkg, recording type information in info.
 func (pkg *Package) Check(importer types.Importer, fset *token.FileSet, info *types.Info) error {
-	log.Printf("Type-check:\t%s \"%s\"", pkg.pkg.Path(), pkg.pkg.Name())
+	pkg.info = info
+
+	pkg.log.Debug("type-check", "package", pkg.pkg.Path(), "name", pkg.pkg.Name())
 
 	cfg := &types.Config{
 		Error: func(err error) {
-			// log.Printf("Warning: %v", err)
+			// pkg.log.Debug("type error", "error", err)
 		},
 		Importer: importer,
 	}
@@ -77,7 +82,7 @@ func (pkg *Package) Find(id protogen.GoIdent) (obj types.Object, ancestors []typ
 		}
 	}
 	if obj == nil {
-		log.Printf("Warning: unable to find declaration %s.%s", id.GoImportPath, id.GoName)
+		pkg.log.Debug("declaration not found", "id", id)
 	}
 	return
 }
@@ -9,18 +9,26 @@ import (
 	"go/printer"
 	"go/token"
 	"go/types"
-	"log"
-	"path/filepath"
+	"log/slog"
+	"path"
 	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/fatih/structtag"
 	"golang.org/x/tools/go/ast/astutil"
 	"google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/pluginpb"
 
 	"github.com/alta/protopatch/lint"
+	"github.com/alta/protopatch/patch/gopb"
 	"github.com/alta/protopatch/patch/ident"
 )
 
@@ -32,11 +40,23 @@ import (
 // - (go.oneof).tags lets you specify additional struct tags on a oneof field.
 // - (go.enum).name overrides the name of an enum type.
 // - (go.value).name overrides the name of an enum value.
+// - (go.file) specifies default options for every applicable element in a file.
 type Patcher struct {
 	gen            *protogen.Plugin
 	fset           *token.FileSet
 	filesByName    map[string]*ast.File
+	parents        map[ast.Node]ast.Node
+	indexed        map[*ast.File]bool
+	parentsMu      sync.RWMutex
 	info           *types.Info
+	log            *slog.Logger
+	parallelism    int
+	strict         bool
+	manifest       bool
+	warningsMu     sync.Mutex
+	warnings       []string
+	exports        *exportImporter
+	packagesMu     sync.Mutex
 	packages       []*Package
 	packagesByPath map[string]*Package
 	packagesByName map[string]*Package
@@ -52,12 +72,67 @@ type Patcher struct {
 	fieldEmbeds    map[types.Object]string
 	types          map[protogen.GoIdent]string
 	fieldTypes     map[types.Object]string
+	elemTypes      map[protogen.GoIdent]elementType
+	fieldElemTypes map[types.Object]elementType
+	exportedNames  map[protogen.GoIdent]string
+	aliases        map[string][]alias
+	renameRules    map[string][]renameRule
+	decls          map[string][]byte
+	declared       map[protogen.GoImportPath]map[string]bool
+	module         string
+}
+
+// Option configures a Patcher.
+type Option func(*Patcher)
+
+// WithParallelism limits the number of Go packages type-checked or patched concurrently to n.
+// The default is runtime.GOMAXPROCS(0). If n < 1, packages are processed serially.
+func WithParallelism(n int) Option {
+	return func(p *Patcher) {
+		if n < 1 {
+			n = 1
+		}
+		p.parallelism = n
+	}
+}
+
+// WithImportMode sets how imports of Go packages that are not being patched are resolved.
+// The default is ImportStub.
+func WithImportMode(mode ImportMode) Option {
+	return func(p *Patcher) {
+		p.exports = nil
+		if mode == ImportPackages {
+			p.exports = newExportImporter(token.NewFileSet())
+		}
+	}
+}
+
+// WithLogger sets the logger for a Patcher. The default is slog.Default().
+// Trace output is logged at slog.LevelDebug, and warnings at slog.LevelWarn.
+func WithLogger(logger *slog.Logger) Option {
+	return func(p *Patcher) {
+		if logger != nil {
+			p.log = logger
+		}
+	}
+}
+
+// WithStrict enables strict mode, which fails Patch if any warnings are logged.
+// The warnings are returned as an error in the CodeGeneratorResponse.
+func WithStrict(strict bool) Option {
+	return func(p *Patcher) {
+		p.strict = strict
+	}
 }
 
 // NewPatcher returns an initialized Patcher for gen.
-func NewPatcher(gen *protogen.Plugin) (*Patcher, error) {
+func NewPatcher(gen *protogen.Plugin, opts ...Option) (*Patcher, error) {
 	p := &Patcher{
 		gen:            gen,
+		log:            slog.Default(),
+		parallelism:    runtime.GOMAXPROCS(0),
+		parents:        make(map[ast.Node]ast.Node),
+		indexed:        make(map[*ast.File]bool),
 		packagesByPath: make(map[string]*Package),
 		packagesByName: make(map[string]*Package),
 		renames:        make(map[protogen.GoIdent]string),
@@ -72,10 +147,45 @@ func NewPatcher(gen *protogen.Plugin) (*Patcher, error) {
 		fieldEmbeds:    make(map[types.Object]string),
 		types:          make(map[protogen.GoIdent]string),
 		fieldTypes:     make(map[types.Object]string),
+		elemTypes:      make(map[protogen.GoIdent]elementType),
+		fieldElemTypes: make(map[types.Object]elementType),
+		exportedNames:  make(map[protogen.GoIdent]string),
+		aliases:        make(map[string][]alias),
+		renameRules:    make(map[string][]renameRule),
+		declared:       make(map[protogen.GoImportPath]map[string]bool),
+		module:         paramValue(gen.Request, "module"),
+	}
+	for _, opt := range opts {
+		opt(p)
 	}
 	return p, p.scan()
 }
 
+// warn logs a warning about a patch that may not apply as specified.
+// In strict mode, the warning is also recorded as an error.
+func (p *Patcher) warn(msg string, args ...any) {
+	p.log.Warn(msg, args...)
+	if !p.strict {
+		return
+	}
+	var b strings.Builder
+	b.WriteString(msg)
+	for i := 0; i+1 < len(args); i += 2 {
+		fmt.Fprintf(&b, " %v=%v", args[i], args[i+1])
+	}
+	p.warningsMu.Lock()
+	p.warnings = append(p.warnings, b.String())
+	p.warningsMu.Unlock()
+}
+
+// warnNotFound warns that id was not found, if any of its ancestors were found.
+// Identifiers whose top-level declaration is missing are declared in proto files that were not generated.
+func (p *Patcher) warnNotFound(id protogen.GoIdent, ancestors []types.Object) {
+	if len(ancestors) > 0 {
+		p.warn("unable to find declaration", "id", id)
+	}
+}
+
 func (p *Patcher) scan() error {
 	for _, f := range p.gen.Files {
 		p.scanFile(f)
@@ -84,17 +194,19 @@ func (p *Patcher) scan() error {
 }
 
 func (p *Patcher) scanFile(f *protogen.File) {
-	log.Printf("\nScan proto:\t%s", f.Desc.Path())
+	p.log.Debug("scan proto", "path", f.Desc.Path())
 
 	// Locally generate Go from the source proto file.
 	// This is equivalent to running the go protoc plugin, but in-process.
 	if f.Generate {
-		log.Printf("Generating:\t%s", f.Desc.Path())
+		p.log.Debug("generate", "path", f.Desc.Path())
 		internal_gengo.GenerateFile(p.gen, f)
 	}
 
 	_ = p.getPackage(string(f.GoImportPath), string(f.GoPackageName), true)
 
+	p.renameRules[f.Desc.Path()] = p.compileRenameRules(f)
+
 	for _, e := range f.Enums {
 		p.scanEnum(e, nil)
 	}
@@ -112,14 +224,11 @@ func (p *Patcher) scanFile(f *protogen.File) {
 
 func (p *Patcher) scanEnum(e *protogen.Enum, parent *protogen.Message) {
 	opts := enumOptions(e)
+	fileOpts := fileOptions(e.Desc)
 	lints := fileLintOptions(e.Desc)
 
 	// Rename enum?
-	newName := opts.GetName()
-	if newName == "" && parent != nil && p.isRenamed(parent.GoIdent) {
-		newName = replacePrefix(e.GoIdent.GoName, parent.GoIdent.GoName, p.nameFor(parent.GoIdent))
-		log.Printf("•••• %s → newName: %s", e.GoIdent.GoName, newName)
-	}
+	newName := p.ruleName(e.GoIdent.GoName, opts.GetName(), parent, e.Desc, (*gopb.Rename).GetEnums)
 	if lints.GetEnums() || lints.GetAll() {
 		if newName == "" {
 			newName = e.GoIdent.GoName
@@ -132,13 +241,18 @@ func (p *Patcher) scanEnum(e *protogen.Enum, parent *protogen.Message) {
 		p.RenameValue(ident.WithSuffix(e.GoIdent, "_value"), newName+"_value") // Enum value map
 	}
 
+	// Alias the original name?
+	if aliasOption(fileOpts, opts) {
+		p.Alias(e.Desc, e.GoIdent, false)
+	}
+
 	// Rename String method?
 	newStringer := opts.GetStringer()
 	// TODO: remove StringerName in two minor versions (~0.3.0)
 	if newStringer == "" {
 		newStringer = opts.GetStringerName()
 		if newStringer != "" {
-			log.Printf("Warning: stringer_name is deprecated and will be removed in a future version. Please use stringer.")
+			p.warn("stringer_name is deprecated and will be removed in a future version; use stringer instead", "enum", e.Desc.FullName())
 		}
 	}
 	if newStringer != "" {
@@ -149,6 +263,7 @@ func (p *Patcher) scanEnum(e *protogen.Enum, parent *protogen.Message) {
 	for _, v := range e.Values {
 		p.scanEnumValue(v, parent)
 	}
+	p.checkEnumValueNames(e)
 }
 
 func (p *Patcher) scanEnumValue(v *protogen.EnumValue, parent *protogen.Message) {
@@ -159,6 +274,7 @@ func (p *Patcher) scanEnumValue(v *protogen.EnumValue, parent *protogen.Message)
 		parentIdent = parent.GoIdent
 	}
 	opts := valueOptions(v)
+	fileOpts := fileOptions(v.Desc)
 	lints := fileLintOptions(v.Desc)
 
 	// Rename enum value?
@@ -184,19 +300,22 @@ func (p *Patcher) scanEnumValue(v *protogen.EnumValue, parent *protogen.Message)
 	if newName != "" {
 		p.RenameValue(v.GoIdent, newName)
 	}
+
+	// Alias the original name?
+	if aliasOption(fileOpts, opts, enumOptions(v.Parent)) {
+		p.Alias(v.Desc, v.GoIdent, true)
+	}
 }
 
 func (p *Patcher) scanMessage(m *protogen.Message, parent *protogen.Message) {
 	opts := messageOptions(m)
+	fileOpts := fileOptions(m.Desc)
 	lints := fileLintOptions(m.Desc)
 
 	// Rename message?
-	newName := opts.GetName()
-	if newName == "" && parent != nil && p.isRenamed(parent.GoIdent) {
-		newName = replacePrefix(m.GoIdent.GoName, parent.GoIdent.GoName, p.nameFor(parent.GoIdent))
-	}
+	newName := p.ruleName(m.GoIdent.GoName, opts.GetName(), parent, m.Desc, (*gopb.Rename).GetMessages)
 	if lints.GetMessages() || lints.GetAll() {
-		log.Printf("Linting: %q.%s", m.GoIdent.GoImportPath, m.GoIdent.GoName)
+		p.log.Debug("lint", "id", m.GoIdent)
 		if newName == "" {
 			newName = m.GoIdent.GoName
 		}
@@ -206,6 +325,11 @@ func (p *Patcher) scanMessage(m *protogen.Message, parent *protogen.Message) {
 		p.RenameType(m.GoIdent, newName) // Message struct
 	}
 
+	// Alias the original name?
+	if aliasOption(fileOpts, opts) {
+		p.Alias(m.Desc, m.GoIdent, false)
+	}
+
 	// Scan message oneof fields.
 	for _, o := range m.Oneofs {
 		p.scanOneof(o)
@@ -234,9 +358,109 @@ func replacePrefix(s, prefix, with string) string {
 	return with + strings.TrimPrefix(s, prefix)
 }
 
+// ruleName returns the Go name for a message or enum with generated Go name name, nested in parent, if any.
+// An explicit newName from a name option is returned unchanged. Otherwise, the rename rules of the file
+// for which applies returns true are applied to the element’s own name, without the names of its parents,
+// and the prefix and suffix options are added if the element is not nested.
+// The name of a nested element is rebuilt from the Go name of its parent.
+// It returns an empty string if the name is not renamed.
+func (p *Patcher) ruleName(name, newName string, parent *protogen.Message, d protoreflect.Descriptor, applies func(*gopb.Rename) bool) string {
+	if newName != "" {
+		return newName
+	}
+	s := name
+	if parent != nil {
+		s = strings.TrimPrefix(name, parent.GoIdent.GoName+"_")
+	}
+	for _, r := range p.renameRules[d.ParentFile().Path()] {
+		if applies(r.Rename) {
+			s = r.apply(s)
+		}
+	}
+	if parent != nil {
+		s = p.nameFor(parent.GoIdent) + "_" + s
+	} else {
+		fileOpts := fileOptions(d)
+		s = fileOpts.GetPrefix() + s + fileOpts.GetSuffix()
+	}
+	if s == name {
+		return ""
+	}
+	return s
+}
+
+// renameRule is a rule from the renames file option, with its compiled pattern, if any.
+type renameRule struct {
+	*gopb.Rename
+	pattern *regexp.Regexp
+}
+
+// compileRenameRules returns the rename rules in the file options of f.
+// Rules with an invalid pattern are skipped with a warning.
+func (p *Patcher) compileRenameRules(f *protogen.File) []renameRule {
+	var rules []renameRule
+	for _, r := range fileOptions(f.Desc).GetRenames() {
+		rule := renameRule{Rename: r}
+		if r.Pattern != nil {
+			x, err := regexp.Compile(r.GetPattern())
+			if err != nil {
+				p.warn("invalid rename pattern", "file", f.Desc.Path(), "pattern", r.GetPattern(), "error", err)
+				continue
+			}
+			rule.pattern = x
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// apply returns name renamed by rule r.
+func (r renameRule) apply(name string) string {
+	if r.pattern != nil {
+		if !r.pattern.MatchString(name) {
+			return name
+		}
+		if r.Replace != nil {
+			name = r.pattern.ReplaceAllString(name, r.GetReplace())
+		}
+	}
+	name = strings.TrimPrefix(name, r.GetTrimPrefix())
+	name = strings.TrimSuffix(name, r.GetTrimSuffix())
+	return r.GetPrefix() + name + r.GetSuffix()
+}
+
+// aliasOption returns the value of the first alias option set in opts, in order of precedence,
+// or the alias option in fileOpts.
+func aliasOption(fileOpts *gopb.FileOptions, opts ...*gopb.Options) bool {
+	for _, o := range opts {
+		if o != nil && o.Alias != nil {
+			return o.GetAlias()
+		}
+	}
+	return fileOpts.GetAlias()
+}
+
+// getterName returns the name of the getter method for a field or oneof,
+// or an empty string if the getter method is not renamed.
+// The name argument is the generated Go name, and newName is the renamed Go name, if any.
+func getterName(opts *gopb.Options, fileOpts *gopb.FileOptions, name, newName string) string {
+	switch {
+	case opts.GetGetter() != "":
+		return opts.GetGetter()
+	case fileOpts.GetGetter() != "" && newName != "":
+		return fileOpts.GetGetter() + newName
+	case fileOpts.GetGetter() != "":
+		return fileOpts.GetGetter() + name
+	case newName != "":
+		return "Get" + newName
+	}
+	return ""
+}
+
 func (p *Patcher) scanOneof(o *protogen.Oneof) {
 	m := o.Parent
 	opts := oneofOptions(o)
+	fileOpts := fileOptions(o.Desc)
 	lints := fileLintOptions(o.Desc)
 
 	// Rename oneof field?
@@ -245,6 +469,10 @@ func (p *Patcher) scanOneof(o *protogen.Oneof) {
 		// Implicitly rename this oneof field because its parent message was renamed.
 		newName = o.GoName
 	}
+	if newName == "" && lints.GetOneofWrappers() {
+		// Implicitly rename this oneof field so its interface type is renamed.
+		newName = o.GoName
+	}
 	if lints.GetFields() || lints.GetAll() {
 		if newName == "" {
 			newName = o.GoIdent.GoName
@@ -252,14 +480,18 @@ func (p *Patcher) scanOneof(o *protogen.Oneof) {
 		newName = lint.Name(newName, lints.InitialismsMap())
 	}
 	if newName != "" {
-		p.RenameField(ident.WithChild(m.GoIdent, o.GoName), newName, false)       // Oneof
-		p.RenameMethod(ident.WithChild(m.GoIdent, "Get"+o.GoName), "Get"+newName) // Getter
+		p.RenameField(ident.WithChild(m.GoIdent, o.GoName), newName, false) // Oneof
 		ifName := ident.WithPrefix(o.GoIdent, "is")
-		newIfName := "is" + p.nameFor(m.GoIdent) + "_" + newName
+		newIfName := "is" + p.nameFor(m.GoIdent) + oneofSeparator(lints) + newName
 		p.RenameType(ifName, newIfName)                                   // Interface type (e.g. isExample_Person)
 		p.RenameMethod(ident.WithChild(ifName, ifName.GoName), newIfName) // Interface method
 	}
 
+	// Rename getter?
+	if getter := getterName(opts, fileOpts, o.GoName, newName); getter != "" {
+		p.RenameMethod(ident.WithChild(m.GoIdent, "Get"+o.GoName), getter) // Getter
+	}
+
 	// Add or replace any struct tags?
 	tags := opts.GetTags()
 	if tags != "" {
@@ -274,6 +506,7 @@ func (p *Patcher) scanField(f *protogen.Field) {
 		o = nil
 	}
 	opts := fieldOptions(f)
+	fileOpts := fileOptions(f.Desc)
 	lints := fileLintOptions(f.Desc)
 
 	// Rename message field?
@@ -282,14 +515,18 @@ func (p *Patcher) scanField(f *protogen.Field) {
 		// Implicitly rename this oneof field because its parent(s) were renamed.
 		newName = f.GoName
 	}
+	if newName == "" && o != nil && lints.GetOneofWrappers() {
+		// Implicitly rename this oneof field so its wrapper type is renamed.
+		newName = f.GoName
+	}
 	// Embed field ?
 	embed := false
 	if opts.GetEmbed() {
 		switch {
 		case f.Message == nil:
-			log.Printf("Warning: embed declared for non-message field: %s", f.Desc.Name())
+			p.warn("embed declared for non-message field", "field", f.Desc.FullName())
 		case f.Oneof != nil:
-			log.Printf("Warning: embed declared for oneof field: %s", f.Desc.Name())
+			p.warn("embed declared for oneof field", "field", f.Desc.FullName())
 		default:
 			embed = true
 			// use the embed field message type's go name or rename option if defined
@@ -306,23 +543,54 @@ func (p *Patcher) scanField(f *protogen.Field) {
 		}
 		newName = lint.Name(newName, lints.InitialismsMap())
 	}
-	if newName != "" {
+	// Unexport field?
+	fieldName := newName
+	if opts.GetPrivate() {
+		switch {
+		case o != nil:
+			p.warn("private declared for oneof field", "field", f.Desc.FullName())
+		case embed:
+			p.warn("private declared for embedded field", "field", f.Desc.FullName())
+		default:
+			exportedName := newName
+			if exportedName == "" {
+				exportedName = f.GoName
+			}
+			fieldName = unexportedName(exportedName)
+			if p.isValidPrivateName(m, f, fieldName) {
+				p.exportedNames[ident.WithChild(m.GoIdent, f.GoName)] = exportedName
+			} else {
+				p.warn("private field name conflicts with a reserved or existing name", "field", f.Desc.FullName(), "name", fieldName)
+				fieldName = newName
+			}
+		}
+	}
+	if fieldName != "" {
 		if o != nil {
-			p.RenameType(f.GoIdent, p.nameFor(m.GoIdent)+"_"+newName)           // Oneof wrapper struct
+			wrapperName := p.nameFor(m.GoIdent) + oneofSeparator(lints) + newName
+			if lints.GetOneofWrappers() && p.isDeclared(f.GoIdent.GoImportPath, wrapperName) {
+				p.warn("oneof wrapper name conflicts with an existing name", "field", f.Desc.FullName(), "name", wrapperName)
+				wrapperName = p.nameFor(m.GoIdent) + "_" + newName
+			}
+			p.RenameType(f.GoIdent, wrapperName)                                // Oneof wrapper struct
 			p.RenameField(ident.WithChild(f.GoIdent, f.GoName), newName, false) // Oneof wrapper field (not embeddable)
 			ifName := ident.WithPrefix(o.GoIdent, "is")
 			p.RenameMethod(ident.WithChild(f.GoIdent, ifName.GoName), p.nameFor(ifName)) // Oneof interface method
 		} else {
-			p.RenameField(ident.WithChild(m.GoIdent, f.GoName), newName, embed) // Field
+			p.RenameField(ident.WithChild(m.GoIdent, f.GoName), fieldName, embed) // Field
 		}
-		p.RenameMethod(ident.WithChild(m.GoIdent, "Get"+f.GoName), "Get"+newName) // Getter
+	}
+
+	// Rename getter?
+	if getter := getterName(opts, fileOpts, f.GoName, newName); getter != "" {
+		p.RenameMethod(ident.WithChild(m.GoIdent, "Get"+f.GoName), getter) // Getter
 	}
 
 	// check type
 	if fieldType := opts.GetType(); fieldType != "" {
 		switch {
-		case f.Message != nil && !f.Desc.IsList():
-			log.Printf("Warning: type declared for message field: %s", f.Desc.Name())
+		case f.Message != nil && !f.Desc.IsList() && !f.Desc.IsMap():
+			p.warn("type declared for message field", "field", f.Desc.FullName())
 		case f.Oneof != nil && !f.Desc.HasOptionalKeyword():
 			p.Type(ident.WithChild(f.GoIdent, f.GoName), fieldType)
 			p.Type(ident.WithChild(m.GoIdent, "Get"+f.GoName), fieldType)
@@ -332,8 +600,42 @@ func (p *Patcher) scanField(f *protogen.Field) {
 		}
 	}
 
+	// check map key and value types
+	if keyType, valueType := opts.GetKeyType(), opts.GetValueType(); keyType != "" || valueType != "" {
+		switch {
+		case !f.Desc.IsMap():
+			p.warn("key_type or value_type declared for non-map field", "field", f.Desc.FullName())
+		case opts.GetType() != "":
+			p.warn("key_type or value_type declared with type", "field", f.Desc.FullName())
+		case valueType != "" && f.Desc.MapValue().Message() != nil:
+			p.warn("value_type declared for message map value", "field", f.Desc.FullName())
+		default:
+			p.MapType(ident.WithChild(m.GoIdent, f.GoName), keyType, valueType)
+			p.MapType(ident.WithChild(m.GoIdent, "Get"+f.GoName), keyType, valueType)
+		}
+	}
+
+	// check repeated element type
+	if elemType := opts.GetElemType(); elemType != "" {
+		switch {
+		case !f.Desc.IsList():
+			p.warn("elem_type declared for non-repeated field", "field", f.Desc.FullName())
+		case opts.GetType() != "":
+			p.warn("elem_type declared with type", "field", f.Desc.FullName())
+		case f.Message != nil:
+			p.warn("elem_type declared for repeated message field", "field", f.Desc.FullName())
+		default:
+			p.ElemType(ident.WithChild(m.GoIdent, f.GoName), elemType)
+			p.ElemType(ident.WithChild(m.GoIdent, "Get"+f.GoName), elemType)
+		}
+	}
+
 	// Add or replace any struct tags?
 	tags := opts.GetTags()
+	if fileTags := fileOpts.GetTags(); fileTags != "" {
+		// Tags specified on the field take precedence over the file.
+		tags = strings.TrimSpace(fileTags + " " + tags)
+	}
 	if tags != "" {
 		if o != nil {
 			p.Tag(ident.WithChild(f.GoIdent, f.GoName), tags) // Oneof wrapper field tags
@@ -343,6 +645,73 @@ func (p *Patcher) scanField(f *protogen.Field) {
 	}
 }
 
+// isDeclared reports whether a message, oneof wrapper, enum, or enum value in the Go package with import path
+// is declared with name, either by its generated Go name or its patched Go name.
+func (p *Patcher) isDeclared(path protogen.GoImportPath, name string) bool {
+	declared := false
+	check := func(id protogen.GoIdent) {
+		if id.GoName == name || p.nameFor(id) == name {
+			declared = true
+		}
+	}
+	checkEnums := func(enums []*protogen.Enum) {
+		for _, e := range enums {
+			check(e.GoIdent)
+			for _, v := range e.Values {
+				check(v.GoIdent)
+			}
+		}
+	}
+	var checkMessages func(messages []*protogen.Message)
+	checkMessages = func(messages []*protogen.Message) {
+		for _, m := range messages {
+			check(m.GoIdent)
+			for _, field := range m.Fields {
+				if field.Oneof != nil && !field.Desc.HasOptionalKeyword() {
+					check(field.GoIdent)
+				}
+			}
+			checkEnums(m.Enums)
+			checkMessages(m.Messages)
+		}
+	}
+	for _, f := range p.gen.Files {
+		if f.GoImportPath != path {
+			continue
+		}
+		checkEnums(f.Enums)
+		checkMessages(f.Messages)
+	}
+	return declared
+}
+
+// declare records names as declared by generated code in the Go package with import path.
+// If any name is already declared by a message, oneof wrapper, enum, enum value, or generated code,
+// it returns that name, and no names are recorded.
+func (p *Patcher) declare(path protogen.GoImportPath, names ...string) (conflict string) {
+	for _, name := range names {
+		if p.declared[path][name] || p.isDeclared(path, name) {
+			return name
+		}
+	}
+	if p.declared[path] == nil {
+		p.declared[path] = make(map[string]bool)
+	}
+	for _, name := range names {
+		p.declared[path][name] = true
+	}
+	return ""
+}
+
+// oneofSeparator returns the separator between a message name and a oneof field name
+// in generated oneof wrapper types and interfaces.
+func oneofSeparator(lints *gopb.LintOptions) string {
+	if lints.GetOneofWrappers() {
+		return ""
+	}
+	return "_"
+}
+
 func (p *Patcher) scanExtension(f *protogen.Field) {
 	opts := fieldOptions(f)
 	lints := fileLintOptions(f.Desc)
@@ -371,7 +740,7 @@ func (p *Patcher) scanExtension(f *protogen.Field) {
 func (p *Patcher) RenameType(id protogen.GoIdent, newName string) {
 	p.renames[id] = newName
 	p.typeRenames[id] = newName
-	log.Printf("Rename type:\t%s.%s → %s", id.GoImportPath, id.GoName, newName)
+	p.log.Debug("rename type", "id", id, "new_name", newName)
 }
 
 // RenameValue renames the Go value (const or var) specified by id to newName.
@@ -381,7 +750,7 @@ func (p *Patcher) RenameType(id protogen.GoIdent, newName string) {
 func (p *Patcher) RenameValue(id protogen.GoIdent, newName string) {
 	p.renames[id] = newName
 	p.valueRenames[id] = newName
-	log.Printf("Rename value:\t%s.%s → %s", id.GoImportPath, id.GoName, newName)
+	p.log.Debug("rename value", "id", id, "new_name", newName)
 }
 
 // RenameField renames the Go struct field specified by id to newName.
@@ -394,7 +763,7 @@ func (p *Patcher) RenameField(id protogen.GoIdent, newName string, embed bool) {
 	if embed {
 		p.embeds[id] = newName
 	}
-	log.Printf("Rename field:\t%s.%s → %s", id.GoImportPath, id.GoName, newName)
+	p.log.Debug("rename field", "id", id, "new_name", newName)
 }
 
 // RenameMethod renames the Go struct or interface method specified by id to newName.
@@ -404,7 +773,16 @@ func (p *Patcher) RenameField(id protogen.GoIdent, newName string, embed bool) {
 func (p *Patcher) RenameMethod(id protogen.GoIdent, newName string) {
 	p.renames[id] = newName
 	p.methodRenames[id] = newName
-	log.Printf("Rename method:\t%s.%s → %s", id.GoImportPath, id.GoName, newName)
+	p.log.Debug("rename method", "id", id, "new_name", newName)
+}
+
+// Alias generates a Go alias with the original name of the type or value specified by id,
+// if it is renamed. The alias is declared in the Go file generated for the proto file of d.
+// The value of id.GoName should be the original generated identifier name, not a renamed identifier.
+func (p *Patcher) Alias(d protoreflect.Descriptor, id protogen.GoIdent, value bool) {
+	path := d.ParentFile().Path()
+	p.aliases[path] = append(p.aliases[path], alias{id, value})
+	p.log.Debug("alias", "id", id)
 }
 
 func (p *Patcher) isRenamed(id protogen.GoIdent) bool {
@@ -423,11 +801,36 @@ func (p *Patcher) nameFor(id protogen.GoIdent) string {
 // The typeName value must be a named type, e.g.: "type String string"
 func (p *Patcher) Type(id protogen.GoIdent, typeName string) {
 	if isTypeValid(typeName) {
-		log.Printf("Warning: field %s has invalid type option: %s", id.GoImportPath, typeName)
+		p.warn("field has invalid type option", "id", id, "type", typeName)
 		return
 	}
 	p.types[id] = typeName
-	log.Printf("Cast type:\t%s.%s → %s", id.GoImportPath, id.GoName, typeName)
+	p.log.Debug("cast type", "id", id, "type", typeName)
+}
+
+// MapType casts the keys and values of the Go map struct field specified by id to keyType and valueType.
+// Either type may be empty to keep the generated key or value type.
+// The keyType and valueType values must be named types, e.g.: "type UserID string"
+func (p *Patcher) MapType(id protogen.GoIdent, keyType, valueType string) {
+	for _, typeName := range []string{keyType, valueType} {
+		if isTypeValid(typeName) {
+			p.warn("field has invalid key_type or value_type option", "id", id, "type", typeName)
+			return
+		}
+	}
+	p.elemTypes[id] = elementType{key: keyType, elem: valueType}
+	p.log.Debug("cast map type", "id", id, "key_type", keyType, "value_type", valueType)
+}
+
+// ElemType casts the elements of the Go slice struct field specified by id to elemType.
+// The elemType value must be a named type, e.g.: "type UserID string"
+func (p *Patcher) ElemType(id protogen.GoIdent, elemType string) {
+	if isTypeValid(elemType) {
+		p.warn("field has invalid elem_type option", "id", id, "type", elemType)
+		return
+	}
+	p.elemTypes[id] = elementType{elem: elemType}
+	p.log.Debug("cast elem type", "id", id, "elem_type", elemType)
 }
 
 // Tag adds the specified struct tags to the field specified by selector,
@@ -436,12 +839,13 @@ func (p *Patcher) Type(id protogen.GoIdent, typeName string) {
 // The struct tags will be applied when Patch is called.
 func (p *Patcher) Tag(id protogen.GoIdent, tags string) {
 	p.tags[id] = tags
-	log.Printf("Tags:\t%s.%s `%s`", id.GoImportPath, id.GoName, tags)
+	p.log.Debug("tags", "id", id, "tags", tags)
 }
 
 // Patch applies the patch(es) in p the Go files in res.
 // Clone res before calling Patch if you want to retain an unmodified copy.
 // The behavior of calling Patch multiple times is currently undefined.
+// In strict mode, any warnings are returned in res.Error, which fails protoc.
 func (p *Patcher) Patch(res *pluginpb.CodeGeneratorResponse) error {
 	p.reset()
 
@@ -464,12 +868,34 @@ func (p *Patcher) Patch(res *pluginpb.CodeGeneratorResponse) error {
 		return err
 	}
 
-	return p.serializeGoFiles(res)
+	p.generate()
+
+	if err := p.serializeGoFiles(res); err != nil {
+		return err
+	}
+
+	if p.manifest {
+		if err := p.addManifests(res); err != nil {
+			return err
+		}
+	}
+
+	// In strict mode, fail generation with any warnings.
+	if len(p.warnings) > 0 {
+		warnings := append([]string(nil), p.warnings...)
+		sort.Strings(warnings)
+		msg := "protopatch: " + strings.Join(warnings, "\nprotopatch: ")
+		res.Error = &msg
+	}
+	return nil
 }
 
 func (p *Patcher) reset() {
 	p.fset = token.NewFileSet()
 	p.filesByName = make(map[string]*ast.File)
+	p.parents = make(map[ast.Node]ast.Node)
+	p.indexed = make(map[*ast.File]bool)
+	p.decls = make(map[string][]byte)
 }
 
 func (p *Patcher) parseGoFiles(res *pluginpb.CodeGeneratorResponse) error {
@@ -479,7 +905,7 @@ func (p *Patcher) parseGoFiles(res *pluginpb.CodeGeneratorResponse) error {
 		}
 
 		if p.filesByName[*rf.Name] != nil {
-			log.Printf("Skipping duplicate file:\t%s", *rf.Name)
+			p.log.Debug("skip duplicate file", "file", *rf.Name)
 			continue
 		}
 
@@ -491,17 +917,49 @@ func (p *Patcher) parseGoFiles(res *pluginpb.CodeGeneratorResponse) error {
 		// TODO: should we cache these?
 		p.filesByName[*rf.Name] = f
 
-		// FIXME: this will break if the package’s implicit name differs from the types.Package name.
-		if pkg, ok := p.packagesByName[f.Name.Name]; ok {
-			pkg.AddFile(*rf.Name, f)
-		} else {
-			return fmt.Errorf("unknown package: %s", f.Name.Name)
+		pkg, err := p.packageFor(*rf.Name, f)
+		if err != nil {
+			return err
 		}
+		pkg.AddFile(*rf.Name, f)
 	}
 
 	return nil
 }
 
+// packageFor returns the Go package for generated file filename.
+// The file is matched to the proto file it was generated from, using the proto file’s Go import path.
+// Files that do not match a proto file, such as files generated by other plugins with different naming conventions,
+// fall back to the Go package name declared in f.
+func (p *Patcher) packageFor(filename string, f *ast.File) (*Package, error) {
+	var match *protogen.File
+	var matchLen int
+	for _, gf := range p.gen.Files {
+		prefix := p.goFilename(gf, "")
+		if len(prefix) <= matchLen || len(filename) <= len(prefix) || !strings.HasPrefix(filename, prefix) {
+			continue
+		}
+		if c := filename[len(prefix)]; c != '.' && c != '_' {
+			continue
+		}
+		match, matchLen = gf, len(prefix)
+	}
+	if match != nil {
+		return p.getPackage(string(match.GoImportPath), string(match.GoPackageName), true), nil
+	}
+
+	p.packagesMu.Lock()
+	pkg, ok := p.packagesByName[f.Name.Name]
+	p.packagesMu.Unlock()
+	switch {
+	case !ok:
+		return nil, fmt.Errorf("unknown package: %s", f.Name.Name)
+	case pkg == nil:
+		return nil, fmt.Errorf("ambiguous package: %s (file %s)", f.Name.Name, filename)
+	}
+	return pkg, nil
+}
+
 func (p *Patcher) checkGoFiles() error {
 	// Type-check Go packages first to find any missing identifiers.
 	if err := p.checkPackages(); err != nil {
@@ -575,17 +1033,30 @@ func (p *Patcher) checkGoFiles() error {
 
 	// Map cast types
 	for id, typ := range p.types {
-		obj, _ := p.find(id)
+		obj, ancestors := p.find(id)
 		if obj == nil {
+			p.warnNotFound(id, ancestors)
 			continue
 		}
 		p.fieldTypes[obj] = typ
 	}
+	for id, typ := range p.elemTypes {
+		obj, ancestors := p.find(id)
+		if obj == nil {
+			p.warnNotFound(id, ancestors)
+			continue
+		}
+		p.fieldElemTypes[obj] = typ
+	}
+	for _, err := range p.verifyFieldTypes() {
+		p.warn(err.Error())
+	}
 
 	// Map struct tags.
 	for id, tags := range p.tags {
-		obj, _ := p.find(id)
+		obj, ancestors := p.find(id)
 		if obj == nil {
+			p.warnNotFound(id, ancestors)
 			continue
 		}
 		p.fieldTags[obj] = tags
@@ -599,29 +1070,156 @@ func (p *Patcher) parseGoFile(filename string, src interface{}) (*ast.File, erro
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("\nParse Go:\t%s\n", filename)
+	p.log.Debug("parse go", "file", filename)
+	p.indexParents(f)
 	return f, nil
 }
 
+// checkPackages type-checks each Go package after the packages it imports.
+// Independent packages are type-checked concurrently.
 func (p *Patcher) checkPackages() error {
-	p.info = &types.Info{
+	p.info = newInfo()
+
+	for _, pkg := range p.packages {
+		pkg.Reset()
+	}
+
+	pkgs := p.packagesWithFiles()
+	if p.exports != nil {
+		paths := p.importPaths(pkgs)
+		p.log.Debug("load go packages", "paths", paths)
+		if err := p.exports.Load(paths); err != nil {
+			p.log.Debug("unable to load Go packages; imports will be stubbed", "error", err)
+		}
+	}
+	err := p.parallel(pkgs, p.dependencies(pkgs), func(pkg *Package) error {
+		// Resolve symbols defined in this package across all files
+		_, _ = ast.NewPackage(p.fset, pkg.filesByName, nil, nil)
+		return pkg.Check(basicImporter{p}, p.fset, newInfo())
+	})
+	if err != nil {
+		return err
+	}
+
+	// Merge type information deterministically
+	for _, pkg := range pkgs {
+		for k, v := range pkg.info.Types {
+			p.info.Types[k] = v
+		}
+		for k, v := range pkg.info.Defs {
+			p.info.Defs[k] = v
+		}
+		for k, v := range pkg.info.Uses {
+			p.info.Uses[k] = v
+		}
+	}
+	return nil
+}
+
+func newInfo() *types.Info {
+	return &types.Info{
 		Types: make(map[ast.Expr]types.TypeAndValue),
 		Defs:  make(map[*ast.Ident]types.Object),
 		Uses:  make(map[*ast.Ident]types.Object),
 	}
+}
 
-	for _, pkg := range p.packagesByName {
-		pkg.Reset()
+// packagesWithFiles returns the Go packages with one or more files, in the order they were created.
+func (p *Patcher) packagesWithFiles() []*Package {
+	p.packagesMu.Lock()
+	defer p.packagesMu.Unlock()
+	var pkgs []*Package
+	for _, pkg := range p.packages {
+		if len(pkg.files) > 0 {
+			pkgs = append(pkgs, pkg)
+		}
 	}
+	return pkgs
+}
 
-	// Iterate packages deterministically
-	for _, pkg := range p.packages {
-		if len(pkg.files) == 0 {
-			continue
+// importPaths returns the sorted paths of pkgs and the packages they import.
+func (p *Patcher) importPaths(pkgs []*Package) []string {
+	seen := make(map[string]bool)
+	for _, pkg := range pkgs {
+		seen[pkg.pkg.Path()] = true
+		for _, f := range pkg.files {
+			for _, spec := range f.Imports {
+				path, err := strconv.Unquote(spec.Path.Value)
+				if err != nil || path == "unsafe" || path == "C" {
+					continue
+				}
+				seen[path] = true
+			}
 		}
-		// Resolve symbols defined in this package across all files
-		_, _ = ast.NewPackage(p.fset, pkg.filesByName, nil, nil)
-		err := pkg.Check(basicImporter{p}, p.fset, p.info)
+	}
+	paths := make([]string, 0, len(seen))
+	for path := range seen {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// dependencies returns a map of each package in pkgs to the packages in pkgs it imports.
+func (p *Patcher) dependencies(pkgs []*Package) map[*Package][]*Package {
+	deps := make(map[*Package][]*Package, len(pkgs))
+	for _, pkg := range pkgs {
+		seen := make(map[*Package]bool)
+		for _, f := range pkg.files {
+			for _, spec := range f.Imports {
+				path, err := strconv.Unquote(spec.Path.Value)
+				if err != nil {
+					continue
+				}
+				dep := p.getPackage(path, "", false)
+				if dep == nil || dep == pkg || seen[dep] || len(dep.files) == 0 {
+					continue
+				}
+				seen[dep] = true
+				deps[pkg] = append(deps[pkg], dep)
+			}
+		}
+	}
+	return deps
+}
+
+// parallel calls fn for each package in pkgs, after fn has returned for its dependencies in deps.
+// Up to p.parallelism calls to fn run concurrently. If deps contains a cycle,
+// fn is called serially for each package in pkgs.
+func (p *Patcher) parallel(pkgs []*Package, deps map[*Package][]*Package, fn func(*Package) error) error {
+	if p.parallelism <= 1 || hasCycle(pkgs, deps) {
+		for _, pkg := range pkgs {
+			if err := fn(pkg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	done := make(map[*Package]chan struct{}, len(pkgs))
+	for _, pkg := range pkgs {
+		done[pkg] = make(chan struct{})
+	}
+	sem := make(chan struct{}, p.parallelism)
+	errs := make([]error, len(pkgs))
+	var wg sync.WaitGroup
+	for i, pkg := range pkgs {
+		wg.Add(1)
+		go func(i int, pkg *Package) {
+			defer wg.Done()
+			defer close(done[pkg])
+			for _, dep := range deps[pkg] {
+				<-done[dep]
+			}
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			errs[i] = fn(pkg)
+		}(i, pkg)
+	}
+	wg.Wait()
+
+	// Return the first error in package order.
+	for _, err := range errs {
 		if err != nil {
 			return err
 		}
@@ -629,6 +1227,38 @@ func (p *Patcher) checkPackages() error {
 	return nil
 }
 
+// hasCycle reports whether deps contains a dependency cycle between packages in pkgs.
+func hasCycle(pkgs []*Package, deps map[*Package][]*Package) bool {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[*Package]int, len(pkgs))
+	var visit func(pkg *Package) bool
+	visit = func(pkg *Package) bool {
+		switch state[pkg] {
+		case visiting:
+			return true
+		case visited:
+			return false
+		}
+		state[pkg] = visiting
+		for _, dep := range deps[pkg] {
+			if visit(dep) {
+				return true
+			}
+		}
+		state[pkg] = visited
+		return false
+	}
+	for _, pkg := range pkgs {
+		if visit(pkg) {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *Patcher) synthesize(id protogen.GoIdent) error {
 	pkg := p.getPackage(string(id.GoImportPath), id.GoName, true)
 
@@ -651,7 +1281,7 @@ func (p *Patcher) synthesize(id protogen.GoIdent) error {
 		// Synthesize a Go method so a non-call expr works, e.g.: foo.Method
 		fmt.Fprintf(b, "func (%s) %s() {}\n", names[0], names[1])
 	}
-	log.Printf("\nGenerated Go code: %s\n\n%s\n", filename, b.String())
+	p.log.Debug("synthesize go", "file", filename, "code", b.String())
 
 	// Parse and add it to pkg.
 	f, err := p.parseGoFile(filename, b)
@@ -674,6 +1304,8 @@ func (p *Patcher) find(id protogen.GoIdent) (obj types.Object, ancestors []types
 // getPackage finds a getPackage with path, or creates it if it doesn’t exist.
 // If name is empty, getPackage will use the last path element as the package name.
 func (p *Patcher) getPackage(path, name string, create bool) *Package {
+	p.packagesMu.Lock()
+	defer p.packagesMu.Unlock()
 	if pkg, ok := p.packagesByPath[path]; ok {
 		return pkg
 	}
@@ -681,102 +1313,194 @@ func (p *Patcher) getPackage(path, name string, create bool) *Package {
 		return nil
 	}
 	if name == "" {
-		name = filepath.Base(path)
+		name = defaultPackageName(path)
 	}
 	pkg := NewPackage(path, name)
+	pkg.log = p.log
+	p.log.Debug("go package", "package", path, "name", name)
 	name = pkg.pkg.Name() // Get real name
 	p.packagesByPath[path] = pkg
-	p.packagesByName[name] = pkg
+	if _, ok := p.packagesByName[name]; ok {
+		p.packagesByName[name] = nil // Ambiguous
+	} else {
+		p.packagesByName[name] = pkg
+	}
 	p.packages = append(p.packages, pkg)
 	return pkg
 }
 
+// defaultPackageName returns the Go package name for importPath used by protoc-gen-go
+// for an import without a known package name: the last path element,
+// sanitized to a valid Go identifier, e.g. github.com/acme/core/v1 → v1, gopkg.in/yaml.v3 → yaml_v3.
+func defaultPackageName(importPath string) string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, path.Base(importPath))
+	if r, _ := utf8.DecodeRuneInString(name); token.Lookup(name).IsKeyword() || !unicode.IsLetter(r) {
+		return "_" + name
+	}
+	return name
+}
+
 func (p *Patcher) serializeGoFiles(res *pluginpb.CodeGeneratorResponse) error {
 	for _, rf := range res.File {
 		if rf.Name == nil || !strings.HasSuffix(*rf.Name, ".go") || rf.Content == nil {
 			continue
 		}
-		log.Printf("\nSerialize:\t%s\n", *rf.Name)
+		p.log.Debug("serialize", "file", *rf.Name)
 
 		f := p.filesByName[*rf.Name]
 		if f == nil {
 			continue // Should never happen
 		}
 
-		var b strings.Builder
+		var b bytes.Buffer
 		err := format.Node(&b, p.fset, f)
 		if err != nil {
 			return err
 		}
 
+		// Append any generated declarations.
+		if decls := p.decls[*rf.Name]; len(decls) > 0 {
+			b.WriteString("\n")
+			b.Write(decls)
+			src, err := format.Source(b.Bytes())
+			if err != nil {
+				return fmt.Errorf("%s: %w", *rf.Name, err)
+			}
+			b.Reset()
+			b.Write(src)
+		}
+
 		content := b.String()
 		rf.Content = &content
 	}
 	return nil
 }
 
+// patchGoFiles patches each type-checked Go package.
+// Packages are patched concurrently, as each package only modifies its own files.
 func (p *Patcher) patchGoFiles() error {
-	log.Printf("\nDefs")
-	for id, obj := range p.info.Defs {
+	return p.parallel(p.packagesWithFiles(), nil, func(pkg *Package) error {
+		p.patchPackage(pkg)
+		return nil
+	})
+}
+
+func (p *Patcher) patchPackage(pkg *Package) {
+	if pkg.info == nil {
+		return
+	}
+
+	p.log.Debug("patch definitions", "package", pkg.pkg.Path())
+	for _, id := range sortedIdents(pkg.info.Defs) {
+		obj := pkg.info.Defs[id]
 		p.patchTypeDef(id, obj)
 		p.patchIdent(id, obj, true)
 		p.patchTags(id, obj)
-		// if id.IsExported() {
-		// 	f := p.fset.File(id.NamePos)
-		// 	log.Printf("Ident %s:\t%s @ %s", typeString(obj), id.Name, f.Name())
-		// }
+		p.patchPrivateTags(id, obj)
 	}
 
-	log.Printf("\nUses\n")
-	for id, obj := range p.info.Uses {
+	p.log.Debug("patch uses", "package", pkg.pkg.Path())
+	for _, id := range sortedIdents(pkg.info.Uses) {
+		obj := pkg.info.Uses[id]
 		p.patchTypeUsage(id, obj)
 		p.patchIdent(id, obj, false)
 	}
 
-	log.Printf("\nUnresolved\n")
-	for _, f := range p.filesByName {
+	p.log.Debug("patch unresolved", "package", pkg.pkg.Path())
+	for _, f := range pkg.files {
 		for _, id := range f.Unresolved {
 			p.patchIdent(id, nil, false)
 		}
 	}
+}
 
-	return nil
+// sortedIdents returns the identifiers in m in source order, so patches are applied deterministically.
+func sortedIdents(m map[*ast.Ident]types.Object) []*ast.Ident {
+	ids := make([]*ast.Ident, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].Pos() < ids[j].Pos()
+	})
+	return ids
 }
 
 func (p *Patcher) patchIdent(id *ast.Ident, obj types.Object, isDecl bool) {
 	name := p.objectRenames[obj]
 	if name == "" {
-		// log.Printf("Unresolved:\t%v", id)
+		// p.log.Debug("unresolved", "id", id)
 		return
 	}
 	p.patchComments(id, name)
 	if _, ok := p.fieldEmbeds[obj]; ok && isDecl {
-		log.Printf("Renamed %s:\t%s → %s (embedded)", typeString(obj), id.Name, name)
+		p.log.Debug("renamed", "kind", typeString(obj), "name", id.Name, "new_name", name, "embedded", true)
 		id.Name = ""
 	} else {
-		log.Printf("Renamed %s:\t%s → %s", typeString(obj), id.Name, name)
+		p.log.Debug("renamed", "kind", typeString(obj), "name", id.Name, "new_name", name)
 		id.Name = name
 	}
 }
 
+// nodeToString returns the Go source for n, warning if n cannot be printed.
 func (p *Patcher) nodeToString(n ast.Node) string {
 	b := &bytes.Buffer{}
 	if err := printer.Fprint(b, p.fset, n); err != nil {
-		log.Fatal(err)
+		p.warn("unable to print Go syntax", "error", err)
 	}
 	return b.String()
 }
 
-func (p *Patcher) findParentNode(id ast.Node) ast.Node {
-	var node ast.Node
-	astutil.Apply(p.fileOf(id), func(cursor *astutil.Cursor) bool {
-		if cursor.Node() == id {
-			node = cursor.Parent()
-			return false
+// findParentNode returns the parent of node n, or nil if n has no known parent.
+func (p *Patcher) findParentNode(n ast.Node) ast.Node {
+	p.parentsMu.RLock()
+	parent, ok := p.parents[n]
+	p.parentsMu.RUnlock()
+	if ok {
+		return parent
+	}
+	// Index files that were not parsed by parseGoFile.
+	f := p.fileOf(n)
+	if f == nil {
+		return nil
+	}
+	p.indexParents(f)
+	p.parentsMu.RLock()
+	defer p.parentsMu.RUnlock()
+	return p.parents[n]
+}
+
+// indexParents records the parent of each node in f, so findParentNode
+// does not need to walk the entire file for each lookup.
+func (p *Patcher) indexParents(f *ast.File) {
+	p.parentsMu.Lock()
+	defer p.parentsMu.Unlock()
+	if p.indexed[f] {
+		return
+	}
+	astutil.Apply(f, func(cursor *astutil.Cursor) bool {
+		if parent := cursor.Parent(); parent != nil {
+			p.parents[cursor.Node()] = parent
 		}
 		return true
 	}, nil)
-	return node
+	p.indexed[f] = true
+}
+
+// replaceParent records node n as the new parent of child,
+// where n replaces child in child’s former parent.
+func (p *Patcher) replaceParent(child, n ast.Node) {
+	p.parentsMu.Lock()
+	defer p.parentsMu.Unlock()
+	if parent, ok := p.parents[child]; ok {
+		p.parents[n] = parent
+	}
+	p.parents[child] = n
 }
 
 func (p *Patcher) patchTags(id *ast.Ident, obj types.Object) {
@@ -787,7 +1511,7 @@ func (p *Patcher) patchTags(id *ast.Ident, obj types.Object) {
 
 	v, ok := id.Obj.Decl.(*ast.Field)
 	if !ok {
-		log.Printf("Warning: struct tags declared for non-field object: %v `%s`", obj, fieldTags)
+		p.warn("struct tags declared for non-field object", "object", obj, "tags", fieldTags)
 		return
 	}
 
@@ -797,13 +1521,13 @@ func (p *Patcher) patchTags(id *ast.Ident, obj types.Object) {
 
 	tags, err := structtag.Parse(strings.Trim(v.Tag.Value, "`"))
 	if err != nil {
-		log.Printf("Error: parsing struct tags for %q.%s: %s", obj.Pkg().Path(), id.Name, err)
+		p.warn("unable to parse struct tags", "package", obj.Pkg().Path(), "name", id.Name, "error", err)
 		return
 	}
 
 	newTags, err := structtag.Parse(fieldTags)
 	if err != nil {
-		log.Printf("Error: parsing struct tags for %q.%s: %s", obj.Pkg().Path(), id.Name, err)
+		p.warn("unable to parse struct tags", "package", obj.Pkg().Path(), "name", id.Name, "error", err)
 		return
 	}
 
@@ -813,7 +1537,7 @@ func (p *Patcher) patchTags(id *ast.Ident, obj types.Object) {
 
 	v.Tag.Value = "`" + tags.String() + "`"
 
-	log.Printf("Add tags:\t%q.%s `%s`", obj.Pkg().Path(), id.Name, newTags.String())
+	p.log.Debug("add tags", "package", obj.Pkg().Path(), "name", id.Name, "tags", newTags.String())
 }
 
 func (p *Patcher) patchComments(id *ast.Ident, repl string) {
@@ -825,19 +1549,14 @@ func (p *Patcher) patchComments(id *ast.Ident, repl string) {
 	if err != nil {
 		return
 	}
-	log.Printf("Comment:\t%v → %s", x, repl)
+	p.log.Debug("patch comments", "pattern", x, "new_name", repl)
 	patchCommentGroup(doc, x, repl)
 	patchCommentGroup(comment, x, repl)
 }
 
 // Borrowed from https://github.com/golang/tools/blob/HEAD/refactor/rename/rename.go#L543
 func (p *Patcher) findCommentGroups(id *ast.Ident) (doc *ast.CommentGroup, comment *ast.CommentGroup) {
-	f := p.fileOf(id)
-	if f == nil {
-		return
-	}
-	nodes, _ := astutil.PathEnclosingInterval(f, id.Pos(), id.End())
-	for _, node := range nodes {
+	for node := ast.Node(id); node != nil; node = p.findParentNode(node) {
 		switch decl := node.(type) {
 		case *ast.FuncDecl:
 			return decl.Doc, nil
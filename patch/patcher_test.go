@@ -0,0 +1,243 @@
+package patch
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/alta/protopatch/patch/gopb"
+	"github.com/alta/protopatch/tests/enum"
+	"github.com/alta/protopatch/tests/file"
+	"github.com/alta/protopatch/tests/lint"
+	"github.com/alta/protopatch/tests/lint/sub"
+	"github.com/alta/protopatch/tests/message"
+	av1 "github.com/alta/protopatch/tests/packages/a/v1"
+	bv1 "github.com/alta/protopatch/tests/packages/b/v1"
+)
+
+// testRequest returns a CodeGeneratorRequest to generate files, including their dependencies.
+func testRequest(params string, files ...protoreflect.FileDescriptor) *pluginpb.CodeGeneratorRequest {
+	req := &pluginpb.CodeGeneratorRequest{
+		Parameter: proto.String(params),
+	}
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		req.ProtoFile = append(req.ProtoFile, protodesc.ToFileDescriptorProto(fd))
+	}
+	for _, fd := range files {
+		add(fd)
+		req.FileToGenerate = append(req.FileToGenerate, fd.Path())
+	}
+	return req
+}
+
+// testGenerate generates Go code for req with protoc-gen-go, and returns the unpatched response.
+func testGenerate(t testing.TB, req *pluginpb.CodeGeneratorRequest) *pluginpb.CodeGeneratorResponse {
+	t.Helper()
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range gen.Files {
+		if f.Generate {
+			internal_gengo.GenerateFile(gen, f)
+		}
+	}
+	return gen.Response()
+}
+
+// testPatch generates Go code for req with protoc-gen-go, and returns the patched response.
+func testPatch(t testing.TB, req *pluginpb.CodeGeneratorRequest, opts ...Option) *pluginpb.CodeGeneratorResponse {
+	t.Helper()
+
+	res := testGenerate(t, req)
+
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts = append([]Option{WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil)))}, opts...)
+	p, err := NewPatcher(gen, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Patch(res); err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestParallelPatch(t *testing.T) {
+	req := testRequest("paths=import",
+		enum.File_tests_enum_enum_renames_proto,
+		file.File_tests_file_file_renames_proto,
+		file.File_tests_file_file_rules_proto,
+		sub.File_tests_lint_sub_sub_proto,
+		lint.File_tests_lint_lint_proto,
+		message.File_tests_message_message_renames_proto,
+		message.File_tests_message_message_field_types_proto,
+	)
+	want := testPatch(t, req, WithParallelism(1))
+	assert.Contains(t, want.File[0].GetContent(), "type Flavour int32")
+	for i := 0; i < 5; i++ {
+		got := testPatch(t, req, WithParallelism(4))
+		if !assert.Equal(t, len(want.File), len(got.File)) {
+			return
+		}
+		for j := range want.File {
+			assert.Equal(t, want.File[j].GetName(), got.File[j].GetName())
+			assert.Equal(t, want.File[j].GetContent(), got.File[j].GetContent(), want.File[j].GetName())
+		}
+	}
+}
+
+func TestDuplicatePackageNames(t *testing.T) {
+	req := testRequest("paths=import", av1.File_tests_packages_a_v1_a_proto, bv1.File_tests_packages_b_v1_b_proto)
+	res := testPatch(t, req)
+	if !assert.Len(t, res.File, 2) {
+		return
+	}
+	a, b := res.File[0].GetContent(), res.File[1].GetContent()
+	assert.Equal(t, "github.com/alta/protopatch/tests/packages/a/v1/a.pb.go", res.File[0].GetName())
+	assert.Contains(t, a, "package v1")
+	assert.Contains(t, a, "type AThing struct")
+	assert.Contains(t, a, "ID string")
+	assert.Equal(t, "github.com/alta/protopatch/tests/packages/b/v1/b.pb.go", res.File[1].GetName())
+	assert.Contains(t, b, "package v1")
+	assert.Contains(t, b, "type BThing struct")
+	assert.Contains(t, b, "ID string")
+	assert.Contains(t, b, "*v1.AThing")
+}
+
+func TestDefaultPackageName(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"fmt", "fmt"},
+		{"google.golang.org/protobuf/runtime/protoimpl", "protoimpl"},
+		{"github.com/alta/protopatch/tests/packages/a/v1", "v1"},
+		{"v2", "v2"},
+		{"gopkg.in/yaml.v3", "yaml_v3"},
+		{"github.com/example/go-thing", "go_thing"},
+		{"example.com/foo-bar", "foo_bar"},
+		{"example.com/2fa", "_2fa"},
+		{"example.com/type", "_type"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, defaultPackageName(tt.path), tt.path)
+	}
+}
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	req := testRequest("paths=import", enum.File_tests_enum_enum_stringer_proto)
+	testPatch(t, req, WithLogger(logger))
+	out := buf.String()
+	assert.Contains(t, out, `level=DEBUG msg="scan proto" path=tests/enum/enum_stringer.proto`)
+	assert.Contains(t, out, `level=WARN msg="stringer_name is deprecated`)
+	assert.Contains(t, out, "enum=tests.enum.DeprecatedStringerEnum")
+}
+
+func TestStrict(t *testing.T) {
+	req := testRequest("paths=import", enum.File_tests_enum_enum_stringer_proto)
+	res := testPatch(t, req)
+	assert.Nil(t, res.Error)
+
+	res = testPatch(t, req, WithStrict(true))
+	if assert.NotNil(t, res.Error) {
+		assert.Equal(t, "protopatch: stringer_name is deprecated and will be removed in a future version; use stringer instead enum=tests.enum.DeprecatedStringerEnum", res.GetError())
+	}
+
+	req = testRequest("paths=import", message.File_tests_message_message_renames_proto)
+	res = testPatch(t, req, WithStrict(true))
+	assert.Nil(t, res.Error)
+}
+
+func TestStrictInvalidTags(t *testing.T) {
+	req := testRequest("paths=import", message.File_tests_message_struct_tags_proto)
+	res := testPatch(t, req, WithStrict(true))
+	assert.Nil(t, res.Error)
+
+	for _, fd := range req.ProtoFile {
+		if fd.GetName() != message.File_tests_message_struct_tags_proto.Path() {
+			continue
+		}
+		f := fd.MessageType[0].Field[0]
+		f.Options = &descriptorpb.FieldOptions{}
+		proto.SetExtension(f.Options, gopb.E_Field, &gopb.Options{Tags: proto.String(`yaml:"unterminated`)})
+	}
+	res = testPatch(t, req)
+	assert.Nil(t, res.Error)
+
+	res = testPatch(t, req, WithStrict(true))
+	if assert.NotNil(t, res.Error) {
+		assert.Contains(t, res.GetError(), "protopatch: unable to parse struct tags package=github.com/alta/protopatch/tests/message name=Value error=")
+	}
+}
+
+func TestOneofWrapperConflicts(t *testing.T) {
+	req := testRequest("paths=import", lint.File_tests_lint_lint_oneof_wrappers_proto)
+	res := testPatch(t, req, WithStrict(true))
+	assert.Nil(t, res.Error)
+
+	for _, fd := range req.ProtoFile {
+		if fd.GetName() == lint.File_tests_lint_lint_oneof_wrappers_proto.Path() {
+			fd.MessageType = append(fd.MessageType, &descriptorpb.DescriptorProto{Name: proto.String("FigureBox")})
+		}
+	}
+	res = testPatch(t, req)
+	assert.Nil(t, res.Error)
+	var content string
+	for _, rf := range res.File {
+		if rf.GetName() == "github.com/alta/protopatch/tests/lint/lint_oneof_wrappers.pb.go" {
+			content = rf.GetContent()
+		}
+	}
+	if assert.NotEmpty(t, content, "lint_oneof_wrappers.pb.go not generated") {
+		assert.Contains(t, content, "type FigureBox struct")
+		assert.Contains(t, content, "type Figure_Box struct")
+		assert.Contains(t, content, "type FigureCircle struct")
+	}
+
+	res = testPatch(t, req, WithStrict(true))
+	if assert.NotNil(t, res.Error) {
+		assert.Equal(t, "protopatch: oneof wrapper name conflicts with an existing name field=tests.lint.Shape.square name=FigureBox", res.GetError())
+	}
+}
+
+func TestInvalidRenamePattern(t *testing.T) {
+	req := testRequest("paths=import", file.File_tests_file_file_rules_proto)
+	for _, fd := range req.ProtoFile {
+		if fd.GetName() != file.File_tests_file_file_rules_proto.Path() {
+			continue
+		}
+		opts := proto.GetExtension(fd.Options, gopb.E_File).(*gopb.FileOptions)
+		opts.Renames = append(opts.Renames, &gopb.Rename{Messages: proto.Bool(true), Pattern: proto.String("(")})
+		proto.SetExtension(fd.Options, gopb.E_File, opts)
+	}
+	res := testPatch(t, req, WithStrict(true))
+	if assert.NotNil(t, res.Error) {
+		assert.Equal(t, "protopatch: invalid rename pattern file=tests/file/file_rules.proto pattern=( error=error parsing regexp: missing closing ): `(`", res.GetError())
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"io"
 	"io/ioutil"
+	"log/slog"
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/pluginpb"
@@ -35,13 +37,32 @@ func stripParam(s, p string) string {
 	return b.String()
 }
 
+// paramValue returns the value of a named param in req, or an empty string if not present.
+func paramValue(req *pluginpb.CodeGeneratorRequest, p string) string {
+	for _, param := range strings.Split(req.GetParameter(), ",") {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) == 2 && kv[0] == p {
+			return kv[1]
+		}
+	}
+	return ""
+}
+
 // RunPlugin runs a protoc plugin named "protoc-gen-$plugin"
 // and returns the generated CodeGeneratorResponse or an error.
 // Supply a non-nil stderr to override stderr on the called plugin.
 func RunPlugin(plugin string, req *pluginpb.CodeGeneratorRequest, stderr io.Writer) (*pluginpb.CodeGeneratorResponse, error) {
+	return RunPluginWithLogger(plugin, req, stderr, slog.Default())
+}
+
+// RunPluginWithLogger is like RunPlugin, but logs the plugin invocation to logger.
+func RunPluginWithLogger(plugin string, req *pluginpb.CodeGeneratorRequest, stderr io.Writer, logger *slog.Logger) (*pluginpb.CodeGeneratorResponse, error) {
 	if stderr == nil {
 		stderr = os.Stderr
 	}
+	logger = logger.With("plugin", plugin)
+	logger.Debug("run plugin", "parameter", req.GetParameter(), "files", req.GetFileToGenerate())
+	start := time.Now()
 
 	// Marshal the CodeGeneratorRequest.
 	b, err := proto.Marshal(req)
@@ -57,6 +78,7 @@ func RunPlugin(plugin string, req *pluginpb.CodeGeneratorRequest, stderr io.Writ
 	cmd.Stderr = stderr
 	err = cmd.Run()
 	if err != nil {
+		logger.Error("plugin failed", "error", err)
 		return nil, err
 	}
 
@@ -66,6 +88,7 @@ func RunPlugin(plugin string, req *pluginpb.CodeGeneratorRequest, stderr io.Writ
 	if err != nil {
 		return nil, err
 	}
+	logger.Debug("plugin finished", "duration", time.Since(start), "files", len(res.File))
 	return &res, nil
 }
 
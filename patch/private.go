@@ -0,0 +1,220 @@
+package patch
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/fatih/structtag"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// reservedFieldNames are the names of unexported fields in structs generated by protoc-gen-go.
+var reservedFieldNames = map[string]bool{
+	"state":           true,
+	"sizeCache":       true,
+	"unknownFields":   true,
+	"extensionFields": true,
+	"weakFields":      true,
+}
+
+// unexportedName returns name with its leading uppercase letters in lowercase,
+// keeping an initialism in a single case, e.g. Name → name, ID → id, URLPath → urlPath.
+func unexportedName(name string) string {
+	runes := []rune(name)
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+	if n > 1 && n < len(runes) && unicode.IsLower(runes[n]) {
+		n-- // Keep the first letter of the next word, e.g. URLPath → urlPath
+	}
+	for i := 0; i < n; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+// isValidPrivateName reports whether name is a valid unexported Go struct field name for field f of message m,
+// which does not conflict with the name of another field, including the unexported name of another private field.
+func (p *Patcher) isValidPrivateName(m *protogen.Message, f *protogen.Field, name string) bool {
+	if !token.IsIdentifier(name) || token.IsExported(name) || name == "_" || reservedFieldNames[name] {
+		return false
+	}
+	for _, sibling := range m.Fields {
+		if sibling == f {
+			continue
+		}
+		opts := fieldOptions(sibling)
+		if sibling.GoName == name || opts.GetName() == name {
+			return false
+		}
+		if opts.GetPrivate() && sibling.Oneof == nil && !opts.GetEmbed() {
+			exportedName := opts.GetName()
+			if exportedName == "" {
+				exportedName = sibling.GoName
+			}
+			if unexportedName(exportedName) == name {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// patchPrivateTags removes the json struct tag from a struct field declaration unexported by the private option,
+// as encoding/json ignores unexported fields.
+func (p *Patcher) patchPrivateTags(id *ast.Ident, obj types.Object) {
+	v, ok := obj.(*types.Var)
+	if !ok || !v.IsField() || !v.Exported() || id.Name == "" || token.IsExported(id.Name) || id.Obj == nil {
+		return
+	}
+	field, ok := id.Obj.Decl.(*ast.Field)
+	if !ok || field.Tag == nil {
+		return
+	}
+	tags, err := structtag.Parse(strings.Trim(field.Tag.Value, "`"))
+	if err != nil {
+		p.warn("unable to parse struct tags", "package", obj.Pkg().Path(), "name", id.Name, "error", err)
+		return
+	}
+	tags.Delete("json")
+	field.Tag.Value = "`" + tags.String() + "`"
+}
+
+// patchExporters adds a case for each unexported proto field to the protoimpl Exporter functions in Go file gf,
+// so the fields are accessible with reflection when the unsafe package is not available.
+func (p *Patcher) patchExporters(gf *ast.File) {
+	ast.Inspect(gf, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+		sel, ok := assign.Lhs[0].(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Exporter" {
+			return true
+		}
+		if fn, ok := assign.Rhs[0].(*ast.FuncLit); ok {
+			p.patchExporter(gf, fn)
+		}
+		return false
+	})
+}
+
+func (p *Patcher) patchExporter(gf *ast.File, fn *ast.FuncLit) {
+	if len(fn.Body.List) != 1 {
+		return
+	}
+	sw, ok := fn.Body.List[0].(*ast.SwitchStmt)
+	if !ok {
+		return
+	}
+	init, ok := sw.Init.(*ast.AssignStmt)
+	if !ok || len(init.Lhs) != 1 || len(init.Rhs) != 1 {
+		return
+	}
+	v, ok := init.Lhs[0].(*ast.Ident)
+	if !ok {
+		return
+	}
+	assert, ok := init.Rhs[0].(*ast.TypeAssertExpr)
+	if !ok {
+		return
+	}
+	star, ok := assert.Type.(*ast.StarExpr)
+	if !ok {
+		return
+	}
+	typ, ok := star.X.(*ast.Ident)
+	if !ok {
+		return
+	}
+	st := findStructType(gf, typ.Name)
+	if st == nil {
+		return
+	}
+
+	cases := make(map[string]bool)
+	var dflt *ast.CaseClause
+	body := sw.Body.List[:0:0]
+	for _, stmt := range sw.Body.List {
+		cc, ok := stmt.(*ast.CaseClause)
+		if !ok {
+			return
+		}
+		if cc.List == nil {
+			dflt = cc
+			continue
+		}
+		for _, expr := range cc.List {
+			if lit, ok := expr.(*ast.BasicLit); ok {
+				cases[lit.Value] = true
+			}
+		}
+		body = append(body, cc)
+	}
+
+	// New nodes are positioned at the default case (or the end of the switch)
+	// so the printer keeps the existing cases in order.
+	pos := sw.Body.Rbrace
+	if dflt != nil {
+		pos = dflt.Case
+	}
+	newIdent := func(name string) *ast.Ident {
+		return &ast.Ident{NamePos: pos, Name: name}
+	}
+
+	i := 0
+	for _, field := range st.Fields.List {
+		names := field.Names
+		if len(names) == 0 {
+			i++
+			continue
+		}
+		for _, name := range names {
+			index := strconv.Itoa(i)
+			i++
+			if name.Name == "" || token.IsExported(name.Name) || cases[index] || !isProtoField(field) {
+				continue
+			}
+			p.log.Debug("exporter", "type", typ.Name, "field", name.Name, "index", index)
+			body = append(body, &ast.CaseClause{
+				Case:  pos,
+				List:  []ast.Expr{&ast.BasicLit{ValuePos: pos, Kind: token.INT, Value: index}},
+				Colon: pos,
+				Body: []ast.Stmt{&ast.ReturnStmt{
+					Return: pos,
+					Results: []ast.Expr{&ast.UnaryExpr{
+						OpPos: pos,
+						Op:    token.AND,
+						X:     &ast.SelectorExpr{X: newIdent(v.Name), Sel: newIdent(name.Name)},
+					}},
+				}},
+			})
+		}
+	}
+	if dflt != nil {
+		body = append(body, dflt)
+	}
+	sw.Body.List = body
+}
+
+// isProtoField reports whether field is a Go struct field for a proto message field or oneof.
+func isProtoField(field *ast.Field) bool {
+	if field.Tag == nil {
+		return false
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return false
+	}
+	_, ok := reflect.StructTag(tag).Lookup("protobuf")
+	if !ok {
+		_, ok = reflect.StructTag(tag).Lookup("protobuf_oneof")
+	}
+	return ok
+}
@@ -0,0 +1,79 @@
+package patch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/alta/protopatch/patch/gopb"
+	"github.com/alta/protopatch/tests/message"
+)
+
+func TestUnexportedName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Name", "name"},
+		{"ID", "id"},
+		{"URLPath", "urlPath"},
+		{"HTTPServerID", "httpServerID"},
+		{"X", "x"},
+		{"already", "already"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, unexportedName(tt.name), tt.name)
+	}
+}
+
+func TestPrivateFields(t *testing.T) {
+	req := testRequest("paths=import", message.File_tests_message_message_private_proto)
+	res := testPatch(t, req, WithStrict(true))
+	assert.Nil(t, res.Error)
+	var content string
+	for _, rf := range res.File {
+		if rf.GetName() == "github.com/alta/protopatch/tests/message/message_private.pb.go" {
+			content = rf.GetContent()
+		}
+	}
+	if content == "" {
+		t.Fatal("message_private.pb.go not found in response")
+	}
+	assert.Contains(t, content, "\t\t\tcase 4:\n\t\t\t\treturn &v.revision\n")
+
+	setPrivate := func(field *descriptorpb.FieldDescriptorProto, name string) {
+		opts := proto.Clone(field.Options).(*descriptorpb.FieldOptions)
+		if opts == nil {
+			opts = &descriptorpb.FieldOptions{}
+		}
+		proto.SetExtension(opts, gopb.E_Field, &gopb.Options{Name: proto.String(name), Private: proto.Bool(true)})
+		field.Options = opts
+	}
+	for _, fd := range req.ProtoFile {
+		if fd.GetName() != message.File_tests_message_message_private_proto.Path() {
+			continue
+		}
+		for _, field := range fd.MessageType[0].Field {
+			switch field.GetName() {
+			case "name":
+				setPrivate(field, "State") // Reserved
+			case "memo":
+				setPrivate(field, "") // Oneof
+			case "revision":
+				setPrivate(field, "UrlPath") // Conflicts with urlPath
+			}
+		}
+	}
+	res = testPatch(t, req, WithStrict(true))
+	if assert.NotNil(t, res.Error) {
+		assert.Equal(t, []string{
+			"protopatch: private declared for oneof field field=tests.message.Ledger.memo",
+			"protopatch: private field name conflicts with a reserved or existing name field=tests.message.Ledger.name name=state",
+			"protopatch: private field name conflicts with a reserved or existing name field=tests.message.Ledger.revision name=urlPath",
+			"protopatch: private field name conflicts with a reserved or existing name field=tests.message.Ledger.url_path name=urlPath",
+		}, strings.Split(res.GetError(), "\n"))
+	}
+}
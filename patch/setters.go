@@ -0,0 +1,46 @@
+package patch
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// generateSetters generates setter methods for the fields of each message in f with the setters option.
+// Field names and types are read from the patched Go file gf.
+func (p *Patcher) generateSetters(b *bytes.Buffer, f *protogen.File, gf *ast.File) {
+	walkMessages(f.Messages, func(m *protogen.Message) {
+		if !m.Desc.IsMapEntry() {
+			p.generateMessageSetters(b, m, gf)
+		}
+	})
+}
+
+func (p *Patcher) generateMessageSetters(b *bytes.Buffer, m *protogen.Message, gf *ast.File) {
+	enabled := messageOptions(m).GetSetters()
+	var st *ast.StructType
+	name := p.nameFor(m.GoIdent)
+	for _, f := range m.Fields {
+		if opts := fieldOptions(f); opts != nil && opts.Setters != nil {
+			if !opts.GetSetters() {
+				continue
+			}
+		} else if !enabled {
+			continue
+		}
+		if st == nil {
+			if st = findStructType(gf, name); st == nil {
+				p.warn("unable to find struct type for setters", "message", m.Desc.FullName(), "type", name)
+				return
+			}
+		}
+		a := p.fieldAssignment(m, f, gf, st)
+		if a == nil {
+			continue
+		}
+		fmt.Fprintf(b, "// Set%s sets the %s field of x.\n", a.name, a.name)
+		fmt.Fprintf(b, "func (x *%s) Set%s(v %s) {\n\t%s\n}\n\n", name, a.name, a.typ, a.stmt("x", "v"))
+	}
+}
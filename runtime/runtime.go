@@ -0,0 +1,52 @@
+// Package runtime maps proto full names to the Go identifiers generated by protopatch.
+//
+// Go files generated from proto files with the (go.file).registry option register
+// the patched Go names of every message, field, oneof, enum, enum value, and extension
+// in an init function, so reflection-based code can map between protoreflect descriptors
+// and renamed Go identifiers.
+package runtime
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Names maps proto full names to Go identifiers.
+type Names map[protoreflect.FullName]string
+
+var (
+	mu    sync.RWMutex
+	names = make(Names)
+)
+
+// Register registers the Go identifiers for a set of proto full names.
+// It is called by init functions in generated code.
+// Registering a full name again replaces its Go identifier.
+func Register(n Names) {
+	mu.Lock()
+	defer mu.Unlock()
+	for k, v := range n {
+		names[k] = v
+	}
+}
+
+// GoName returns the Go identifier registered for the proto element with full name,
+// or an empty string if none is registered.
+// For a message or enum, this is the name of the Go type.
+// For an enum value, this is the name of the Go const.
+// For a message field or oneof, this is the name of the Go struct field.
+// For an extension, this is the name of the Go var.
+func GoName(name protoreflect.FullName) string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return names[name]
+}
+
+// FieldGoName returns the name of the Go struct field registered for fd,
+// or an empty string if none is registered.
+// For a field in a oneof, this is the name of the field in the oneof wrapper type.
+// The containing struct field is returned by GoName(fd.ContainingOneof().FullName()).
+func FieldGoName(fd protoreflect.FieldDescriptor) string {
+	return GoName(fd.FullName())
+}
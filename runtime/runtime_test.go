@@ -0,0 +1,34 @@
+package runtime
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRegister(t *testing.T) {
+	md := (&timestamppb.Timestamp{}).ProtoReflect().Descriptor()
+	if got := GoName(md.FullName()); got != "" {
+		t.Errorf("GoName(%q) = %q, want empty string", md.FullName(), got)
+	}
+
+	Register(Names{
+		md.FullName():                            "Timestamp",
+		md.Fields().ByName("seconds").FullName(): "Secs",
+	})
+	Register(Names{
+		md.Fields().ByName("seconds").FullName(): "Seconds",
+	})
+
+	if got, want := GoName(md.FullName()), "Timestamp"; got != want {
+		t.Errorf("GoName(%q) = %q, want %q", md.FullName(), got, want)
+	}
+	fd := md.Fields().ByName("seconds")
+	if got, want := FieldGoName(fd), "Seconds"; got != want {
+		t.Errorf("FieldGoName(%q) = %q, want %q", fd.FullName(), got, want)
+	}
+	fd = md.Fields().ByName("nanos")
+	if got := FieldGoName(fd); got != "" {
+		t.Errorf("FieldGoName(%q) = %q, want empty string", fd.FullName(), got)
+	}
+}
@@ -0,0 +1,229 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/enum/enum_aliases.proto
+
+// clang-format off
+
+package enum
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Priority int32
+
+const (
+	PriorityUnspecified Priority = 0
+	PriorityLow         Priority = 1
+	PriorityMinor       Priority = 1
+	PriorityHigh        Priority = 2
+)
+
+// Enum value maps for Priority.
+var (
+	Priority_name = map[int32]string{
+		0: "PRIORITY_UNSPECIFIED",
+		1: "minor",
+		// Duplicate value: 1: "PRIORITY_LOW",
+		2: "PRIORITY_HIGH",
+		// Duplicate value: 2: "PriorityHigh",
+	}
+	Priority_value = map[string]int32{
+		"PRIORITY_UNSPECIFIED": 0,
+		"PRIORITY_LOW":         1,
+		"minor":                1,
+		"PRIORITY_HIGH":        2,
+		"PriorityHigh":         2,
+	}
+)
+
+func (x Priority) Enum() *Priority {
+	p := new(Priority)
+	*p = x
+	return p
+}
+
+func (x Priority) String() string {
+	if s, ok := Priority_name[int32(x)]; ok {
+		return s
+	}
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_enum_enum_aliases_proto_enumTypes[0].Descriptor()
+}
+
+func (Priority) Type() protoreflect.EnumType {
+	return &file_tests_enum_enum_aliases_proto_enumTypes[0]
+}
+
+func (x Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Priority.Descriptor instead.
+func (Priority) EnumDescriptor() ([]byte, []int) {
+	return file_tests_enum_enum_aliases_proto_rawDescGZIP(), []int{0}
+}
+
+var File_tests_enum_enum_aliases_proto protoreflect.FileDescriptor
+
+var file_tests_enum_enum_aliases_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2f, 0x65, 0x6e, 0x75,
+	0x6d, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0a, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x1a, 0x0e, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x8e, 0x01, 0x0a, 0x08,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x49, 0x4f,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c,
+	0x4f, 0x57, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x0e, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59,
+	0x5f, 0x4d, 0x49, 0x4e, 0x4f, 0x52, 0x10, 0x01, 0x1a, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0x82, 0x02,
+	0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x98, 0x02, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x49,
+	0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x48, 0x69, 0x67, 0x68, 0x10, 0x02, 0x1a, 0x0c,
+	0xca, 0xb5, 0x03, 0x06, 0x88, 0x02, 0x01, 0x90, 0x02, 0x01, 0x10, 0x01, 0x42, 0x2d, 0xca, 0xb5,
+	0x03, 0x02, 0x28, 0x01, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_enum_enum_aliases_proto_rawDescOnce sync.Once
+	file_tests_enum_enum_aliases_proto_rawDescData = file_tests_enum_enum_aliases_proto_rawDesc
+)
+
+func file_tests_enum_enum_aliases_proto_rawDescGZIP() []byte {
+	file_tests_enum_enum_aliases_proto_rawDescOnce.Do(func() {
+		file_tests_enum_enum_aliases_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_enum_enum_aliases_proto_rawDescData)
+	})
+	return file_tests_enum_enum_aliases_proto_rawDescData
+}
+
+var file_tests_enum_enum_aliases_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_enum_enum_aliases_proto_goTypes = []any{
+	(Priority)(0), // 0: tests.enum.Priority
+}
+var file_tests_enum_enum_aliases_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_tests_enum_enum_aliases_proto_init() }
+func file_tests_enum_enum_aliases_proto_init() {
+	if File_tests_enum_enum_aliases_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_enum_enum_aliases_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_enum_enum_aliases_proto_goTypes,
+		DependencyIndexes: file_tests_enum_enum_aliases_proto_depIdxs,
+		EnumInfos:         file_tests_enum_enum_aliases_proto_enumTypes,
+	}.Build()
+	File_tests_enum_enum_aliases_proto = out.File
+	file_tests_enum_enum_aliases_proto_rawDesc = nil
+	file_tests_enum_enum_aliases_proto_goTypes = nil
+	file_tests_enum_enum_aliases_proto_depIdxs = nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (x Priority) MarshalText() ([]byte, error) {
+	if s, ok := Priority_name[int32(x)]; ok {
+		return []byte(s), nil
+	}
+	return nil, fmt.Errorf("invalid Priority value: %d", int32(x))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (x *Priority) UnmarshalText(b []byte) error {
+	if v, ok := Priority_value[string(b)]; ok {
+		*x = Priority(v)
+		return nil
+	}
+	if v := x.Descriptor().Values().ByName(protoreflect.Name(b)); v != nil {
+		*x = Priority(v.Number())
+		return nil
+	}
+	return fmt.Errorf("invalid Priority value: %q", b)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Values without a name are encoded as numbers.
+func (x Priority) MarshalJSON() ([]byte, error) {
+	if s, ok := Priority_name[int32(x)]; ok {
+		return json.Marshal(s)
+	}
+	return json.Marshal(int32(x))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts strings, as with UnmarshalText, and numbers.
+func (x *Priority) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var n int32
+	if err := json.Unmarshal(b, &n); err == nil {
+		*x = Priority(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// IsValid reports whether x is a declared Priority value.
+func (x Priority) IsValid() bool {
+	_, ok := Priority_name[int32(x)]
+	return ok
+}
+
+// PriorityValues returns the declared Priority values, in declaration order.
+// Aliases of a value other than the preferred value are omitted.
+func PriorityValues() []Priority {
+	return []Priority{
+		PriorityUnspecified,
+		PriorityMinor,
+		PriorityHigh,
+	}
+}
+
+// ParsePriority returns the Priority value for s, which may be a string returned by String or a proto value name.
+func ParsePriority(s string) (Priority, error) {
+	if v, ok := Priority_value[s]; ok {
+		return Priority(v), nil
+	}
+	if v := Priority(0).Descriptor().Values().ByName(protoreflect.Name(s)); v != nil {
+		return Priority(v.Number()), nil
+	}
+	return 0, fmt.Errorf("invalid Priority value: %q", s)
+}
@@ -0,0 +1,320 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/enum/enum_helpers.proto
+
+// clang-format off
+
+package enum
+
+import (
+	fmt "fmt"
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Status int32
+
+const (
+	StatusUnknown  Status = 0
+	StatusActive   Status = 1
+	StatusDisabled Status = 2
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNKNOWN",
+		1: "active",
+		2: "STATUS_DISABLED",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNKNOWN":  0,
+		"active":          1,
+		"STATUS_DISABLED": 2,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	if s, ok := Status_name[int32(x)]; ok {
+		return s
+	}
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_enum_enum_helpers_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_tests_enum_enum_helpers_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_tests_enum_enum_helpers_proto_rawDescGZIP(), []int{0}
+}
+
+type AccountRole int32
+
+const (
+	Account_ROLE_USER  AccountRole = 0
+	Account_ROLE_ADMIN AccountRole = 1
+)
+
+// Enum value maps for Account_Role.
+var (
+	AccountRole_name = map[int32]string{
+		0: "ROLE_USER",
+		1: "ROLE_ADMIN",
+	}
+	AccountRole_value = map[string]int32{
+		"ROLE_USER":  0,
+		"ROLE_ADMIN": 1,
+	}
+)
+
+func (x AccountRole) Enum() *AccountRole {
+	p := new(AccountRole)
+	*p = x
+	return p
+}
+
+func (x AccountRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_enum_enum_helpers_proto_enumTypes[1].Descriptor()
+}
+
+func (AccountRole) Type() protoreflect.EnumType {
+	return &file_tests_enum_enum_helpers_proto_enumTypes[1]
+}
+
+func (x AccountRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Account_Role.Descriptor instead.
+func (AccountRole) EnumDescriptor() ([]byte, []int) {
+	return file_tests_enum_enum_helpers_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Account struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role AccountRole `protobuf:"varint,1,opt,name=role,proto3,enum=tests.enum.Account_Role" json:"role,omitempty"`
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_enum_enum_helpers_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_enum_enum_helpers_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_tests_enum_enum_helpers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetRole() AccountRole {
+	if x != nil {
+		return x.Role
+	}
+	return Account_ROLE_USER
+}
+
+var File_tests_enum_enum_helpers_proto protoreflect.FileDescriptor
+
+var file_tests_enum_enum_helpers_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2f, 0x65, 0x6e, 0x75,
+	0x6d, 0x5f, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0a, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x1a, 0x0e, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x74, 0x0a, 0x07, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x65, 0x6e, 0x75,
+	0x6d, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x3b, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0d, 0x0a, 0x09,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x01, 0x1a, 0x14, 0xca, 0xb5, 0x03,
+	0x10, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x90, 0x02,
+	0x01, 0x2a, 0x95, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0e,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x2e, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x1a, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0x0a, 0x0c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x82, 0x02, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x29, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10,
+	0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x1a, 0x07, 0xca, 0xb5, 0x03, 0x03, 0x90, 0x02, 0x01, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x65, 0x6e,
+	0x75, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_enum_enum_helpers_proto_rawDescOnce sync.Once
+	file_tests_enum_enum_helpers_proto_rawDescData = file_tests_enum_enum_helpers_proto_rawDesc
+)
+
+func file_tests_enum_enum_helpers_proto_rawDescGZIP() []byte {
+	file_tests_enum_enum_helpers_proto_rawDescOnce.Do(func() {
+		file_tests_enum_enum_helpers_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_enum_enum_helpers_proto_rawDescData)
+	})
+	return file_tests_enum_enum_helpers_proto_rawDescData
+}
+
+var file_tests_enum_enum_helpers_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_tests_enum_enum_helpers_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_tests_enum_enum_helpers_proto_goTypes = []any{
+	(Status)(0),      // 0: tests.enum.Status
+	(AccountRole)(0), // 1: tests.enum.Account.Role
+	(*Account)(nil),  // 2: tests.enum.Account
+}
+var file_tests_enum_enum_helpers_proto_depIdxs = []int32{
+	1, // 0: tests.enum.Account.role:type_name -> tests.enum.Account.Role
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_tests_enum_enum_helpers_proto_init() }
+func file_tests_enum_enum_helpers_proto_init() {
+	if File_tests_enum_enum_helpers_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_enum_enum_helpers_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_enum_enum_helpers_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_enum_enum_helpers_proto_goTypes,
+		DependencyIndexes: file_tests_enum_enum_helpers_proto_depIdxs,
+		EnumInfos:         file_tests_enum_enum_helpers_proto_enumTypes,
+		MessageInfos:      file_tests_enum_enum_helpers_proto_msgTypes,
+	}.Build()
+	File_tests_enum_enum_helpers_proto = out.File
+	file_tests_enum_enum_helpers_proto_rawDesc = nil
+	file_tests_enum_enum_helpers_proto_goTypes = nil
+	file_tests_enum_enum_helpers_proto_depIdxs = nil
+}
+
+// IsValid reports whether x is a declared Status value.
+func (x Status) IsValid() bool {
+	_, ok := Status_name[int32(x)]
+	return ok
+}
+
+// StatusValues returns the declared Status values, in declaration order.
+// Aliases of a value other than the preferred value are omitted.
+func StatusValues() []Status {
+	return []Status{
+		StatusUnknown,
+		StatusActive,
+		StatusDisabled,
+	}
+}
+
+// ParseStatus returns the Status value for s, which may be a string returned by String or a proto value name.
+func ParseStatus(s string) (Status, error) {
+	if v, ok := Status_value[s]; ok {
+		return Status(v), nil
+	}
+	if v := Status(0).Descriptor().Values().ByName(protoreflect.Name(s)); v != nil {
+		return Status(v.Number()), nil
+	}
+	return 0, fmt.Errorf("invalid Status value: %q", s)
+}
+
+// IsValid reports whether x is a declared AccountRole value.
+func (x AccountRole) IsValid() bool {
+	_, ok := AccountRole_name[int32(x)]
+	return ok
+}
+
+// AccountRoleValues returns the declared AccountRole values, in declaration order.
+// Aliases of a value other than the preferred value are omitted.
+func AccountRoleValues() []AccountRole {
+	return []AccountRole{
+		Account_ROLE_USER,
+		Account_ROLE_ADMIN,
+	}
+}
+
+// ParseAccountRole returns the AccountRole value for s, which may be a string returned by String or a proto value name.
+func ParseAccountRole(s string) (AccountRole, error) {
+	if v, ok := AccountRole_value[s]; ok {
+		return AccountRole(v), nil
+	}
+	if v := AccountRole(0).Descriptor().Values().ByName(protoreflect.Name(s)); v != nil {
+		return AccountRole(v.Number()), nil
+	}
+	return 0, fmt.Errorf("invalid AccountRole value: %q", s)
+}
@@ -0,0 +1,373 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/enum/enum_proto2.proto
+
+// clang-format off
+
+package enum
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Weekday int32
+
+const (
+	Weekday_WEEKDAY_MONDAY  Weekday = 1
+	Weekday_WEEKDAY_TUESDAY Weekday = 2
+)
+
+// Enum value maps for Weekday.
+var (
+	Weekday_name = map[int32]string{
+		1: "monday",
+		2: "tuesday",
+	}
+	Weekday_value = map[string]int32{
+		"monday":  1,
+		"tuesday": 2,
+	}
+)
+
+func (x Weekday) Enum() *Weekday {
+	p := new(Weekday)
+	*p = x
+	return p
+}
+
+func (x Weekday) String() string {
+	if s, ok := Weekday_name[int32(x)]; ok {
+		return s
+	}
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weekday) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_enum_enum_proto2_proto_enumTypes[0].Descriptor()
+}
+
+func (Weekday) Type() protoreflect.EnumType {
+	return &file_tests_enum_enum_proto2_proto_enumTypes[0]
+}
+
+func (x Weekday) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weekday.Descriptor instead.
+func (Weekday) EnumDescriptor() ([]byte, []int) {
+	return file_tests_enum_enum_proto2_proto_rawDescGZIP(), []int{0}
+}
+
+type Calendar_Season int32
+
+const (
+	Calendar_SEASON_SPRING Calendar_Season = 1
+	Calendar_SEASON_SUMMER Calendar_Season = 2
+)
+
+// Enum value maps for Calendar_Season.
+var (
+	Calendar_Season_name = map[int32]string{
+		1: "spring",
+		2: "SEASON_SUMMER",
+	}
+	Calendar_Season_value = map[string]int32{
+		"spring":        1,
+		"SEASON_SUMMER": 2,
+	}
+)
+
+func (x Calendar_Season) Enum() *Calendar_Season {
+	p := new(Calendar_Season)
+	*p = x
+	return p
+}
+
+func (x Calendar_Season) String() string {
+	if s, ok := Calendar_Season_name[int32(x)]; ok {
+		return s
+	}
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Calendar_Season) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_enum_enum_proto2_proto_enumTypes[1].Descriptor()
+}
+
+func (Calendar_Season) Type() protoreflect.EnumType {
+	return &file_tests_enum_enum_proto2_proto_enumTypes[1]
+}
+
+func (x Calendar_Season) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Calendar_Season.Descriptor instead.
+func (Calendar_Season) EnumDescriptor() ([]byte, []int) {
+	return file_tests_enum_enum_proto2_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Calendar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weekday *Weekday         `protobuf:"varint,1,opt,name=weekday,enum=tests.enum.Weekday" json:"weekday,omitempty"`
+	Season  *Calendar_Season `protobuf:"varint,2,opt,name=season,enum=tests.enum.Calendar_Season" json:"season,omitempty"`
+}
+
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_enum_enum_proto2_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Calendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar) ProtoMessage() {}
+
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_enum_enum_proto2_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_tests_enum_enum_proto2_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Calendar) GetWeekday() Weekday {
+	if x != nil && x.Weekday != nil {
+		return *x.Weekday
+	}
+	return Weekday_WEEKDAY_MONDAY
+}
+
+func (x *Calendar) GetSeason() Calendar_Season {
+	if x != nil && x.Season != nil {
+		return *x.Season
+	}
+	return Calendar_SEASON_SPRING
+}
+
+var File_tests_enum_enum_proto2_proto protoreflect.FileDescriptor
+
+var file_tests_enum_enum_proto2_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2f, 0x65, 0x6e, 0x75,
+	0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x01, 0x0a, 0x08, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64,
+	0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x52, 0x07, 0x77,
+	0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x65,
+	0x6e, 0x75, 0x6d, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x53, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x06, 0x53,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0d, 0x53, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x53, 0x50, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x1a, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0x82, 0x02,
+	0x06, 0x73, 0x70, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x45, 0x52, 0x10, 0x02, 0x1a, 0x07, 0xca, 0xb5, 0x03, 0x03,
+	0x88, 0x02, 0x01, 0x2a, 0x5a, 0x0a, 0x07, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x21,
+	0x0a, 0x0e, 0x57, 0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x5f, 0x4d, 0x4f, 0x4e, 0x44, 0x41, 0x59,
+	0x10, 0x01, 0x1a, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0x82, 0x02, 0x06, 0x6d, 0x6f, 0x6e, 0x64, 0x61,
+	0x79, 0x12, 0x23, 0x0a, 0x0f, 0x57, 0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x5f, 0x54, 0x55, 0x45,
+	0x53, 0x44, 0x41, 0x59, 0x10, 0x02, 0x1a, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x82, 0x02, 0x07, 0x74,
+	0x75, 0x65, 0x73, 0x64, 0x61, 0x79, 0x1a, 0x07, 0xca, 0xb5, 0x03, 0x03, 0x88, 0x02, 0x01, 0x42,
+	0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
+	0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2f, 0x65, 0x6e, 0x75, 0x6d,
+}
+
+var (
+	file_tests_enum_enum_proto2_proto_rawDescOnce sync.Once
+	file_tests_enum_enum_proto2_proto_rawDescData = file_tests_enum_enum_proto2_proto_rawDesc
+)
+
+func file_tests_enum_enum_proto2_proto_rawDescGZIP() []byte {
+	file_tests_enum_enum_proto2_proto_rawDescOnce.Do(func() {
+		file_tests_enum_enum_proto2_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_enum_enum_proto2_proto_rawDescData)
+	})
+	return file_tests_enum_enum_proto2_proto_rawDescData
+}
+
+var file_tests_enum_enum_proto2_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_tests_enum_enum_proto2_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_tests_enum_enum_proto2_proto_goTypes = []any{
+	(Weekday)(0),         // 0: tests.enum.Weekday
+	(Calendar_Season)(0), // 1: tests.enum.Calendar.Season
+	(*Calendar)(nil),     // 2: tests.enum.Calendar
+}
+var file_tests_enum_enum_proto2_proto_depIdxs = []int32{
+	0, // 0: tests.enum.Calendar.weekday:type_name -> tests.enum.Weekday
+	1, // 1: tests.enum.Calendar.season:type_name -> tests.enum.Calendar.Season
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tests_enum_enum_proto2_proto_init() }
+func file_tests_enum_enum_proto2_proto_init() {
+	if File_tests_enum_enum_proto2_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_enum_enum_proto2_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Calendar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_enum_enum_proto2_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_enum_enum_proto2_proto_goTypes,
+		DependencyIndexes: file_tests_enum_enum_proto2_proto_depIdxs,
+		EnumInfos:         file_tests_enum_enum_proto2_proto_enumTypes,
+		MessageInfos:      file_tests_enum_enum_proto2_proto_msgTypes,
+	}.Build()
+	File_tests_enum_enum_proto2_proto = out.File
+	file_tests_enum_enum_proto2_proto_rawDesc = nil
+	file_tests_enum_enum_proto2_proto_goTypes = nil
+	file_tests_enum_enum_proto2_proto_depIdxs = nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (x Weekday) MarshalText() ([]byte, error) {
+	if s, ok := Weekday_name[int32(x)]; ok {
+		return []byte(s), nil
+	}
+	return nil, fmt.Errorf("invalid Weekday value: %d", int32(x))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (x *Weekday) UnmarshalText(b []byte) error {
+	if v, ok := Weekday_value[string(b)]; ok {
+		*x = Weekday(v)
+		return nil
+	}
+	if v := x.Descriptor().Values().ByName(protoreflect.Name(b)); v != nil {
+		*x = Weekday(v.Number())
+		return nil
+	}
+	return fmt.Errorf("invalid Weekday value: %q", b)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Values without a name are encoded as numbers.
+func (x Weekday) MarshalJSON() ([]byte, error) {
+	if s, ok := Weekday_name[int32(x)]; ok {
+		return json.Marshal(s)
+	}
+	return json.Marshal(int32(x))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts strings, as with UnmarshalText, and numbers.
+func (x *Weekday) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var n int32
+	if err := json.Unmarshal(b, &n); err == nil {
+		*x = Weekday(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (x Calendar_Season) MarshalText() ([]byte, error) {
+	if s, ok := Calendar_Season_name[int32(x)]; ok {
+		return []byte(s), nil
+	}
+	return nil, fmt.Errorf("invalid Calendar_Season value: %d", int32(x))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (x *Calendar_Season) UnmarshalText(b []byte) error {
+	if v, ok := Calendar_Season_value[string(b)]; ok {
+		*x = Calendar_Season(v)
+		return nil
+	}
+	if v := x.Descriptor().Values().ByName(protoreflect.Name(b)); v != nil {
+		*x = Calendar_Season(v.Number())
+		return nil
+	}
+	return fmt.Errorf("invalid Calendar_Season value: %q", b)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Values without a name are encoded as numbers.
+func (x Calendar_Season) MarshalJSON() ([]byte, error) {
+	if s, ok := Calendar_Season_name[int32(x)]; ok {
+		return json.Marshal(s)
+	}
+	return json.Marshal(int32(x))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts strings, as with UnmarshalText, and numbers.
+func (x *Calendar_Season) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var n int32
+	if err := json.Unmarshal(b, &n); err == nil {
+		*x = Calendar_Season(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
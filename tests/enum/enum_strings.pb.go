@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/enum/enum_strings.proto
+
+// clang-format off
+
+package enum
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Color int32
+
+const (
+	Color_COLOR_UNSPECIFIED Color = 0
+	Color_COLOR_RED         Color = 1
+	Color_COLOR_GREEN       Color = 2
+	Color_COLOR_BLUE        Color = 3
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0: "unspecified",
+		1: "red",
+		2: "green",
+		3: "COLOR_BLUE",
+	}
+	Color_value = map[string]int32{
+		"unspecified": 0,
+		"red":         1,
+		"green":       2,
+		"COLOR_BLUE":  3,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	if s, ok := Color_name[int32(x)]; ok {
+		return s
+	}
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_enum_enum_strings_proto_enumTypes[0].Descriptor()
+}
+
+func (Color) Type() protoreflect.EnumType {
+	return &file_tests_enum_enum_strings_proto_enumTypes[0]
+}
+
+func (x Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Color.Descriptor instead.
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return file_tests_enum_enum_strings_proto_rawDescGZIP(), []int{0}
+}
+
+type Form int32
+
+const (
+	Form_SHAPE_CIRCLE Form = 0
+	Form_SHAPE_SQUARE Form = 1
+)
+
+// Enum value maps for Shape.
+var (
+	Form_name = map[int32]string{
+		0: "circle",
+		1: "square",
+	}
+	Form_value = map[string]int32{
+		"circle": 0,
+		"square": 1,
+	}
+)
+
+func (x Form) Enum() *Form {
+	p := new(Form)
+	*p = x
+	return p
+}
+
+func (x Form) Label() string {
+	if s, ok := Form_name[int32(x)]; ok {
+		return s
+	}
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Form) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_enum_enum_strings_proto_enumTypes[1].Descriptor()
+}
+
+func (Form) Type() protoreflect.EnumType {
+	return &file_tests_enum_enum_strings_proto_enumTypes[1]
+}
+
+func (x Form) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Shape.Descriptor instead.
+func (Form) EnumDescriptor() ([]byte, []int) {
+	return file_tests_enum_enum_strings_proto_rawDescGZIP(), []int{1}
+}
+
+type Canvas_Layer int32
+
+const (
+	Canvas_LAYER_BACKGROUND Canvas_Layer = 0
+	Canvas_LAYER_FOREGROUND Canvas_Layer = 1
+)
+
+// Enum value maps for Canvas_Layer.
+var (
+	Canvas_Layer_name = map[int32]string{
+		0: "background",
+		1: "foreground",
+	}
+	Canvas_Layer_value = map[string]int32{
+		"background": 0,
+		"foreground": 1,
+	}
+)
+
+func (x Canvas_Layer) Enum() *Canvas_Layer {
+	p := new(Canvas_Layer)
+	*p = x
+	return p
+}
+
+func (x Canvas_Layer) String() string {
+	if s, ok := Canvas_Layer_name[int32(x)]; ok {
+		return s
+	}
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Canvas_Layer) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_enum_enum_strings_proto_enumTypes[2].Descriptor()
+}
+
+func (Canvas_Layer) Type() protoreflect.EnumType {
+	return &file_tests_enum_enum_strings_proto_enumTypes[2]
+}
+
+func (x Canvas_Layer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Canvas_Layer.Descriptor instead.
+func (Canvas_Layer) EnumDescriptor() ([]byte, []int) {
+	return file_tests_enum_enum_strings_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Canvas struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Color Color        `protobuf:"varint,1,opt,name=color,proto3,enum=tests.enum.Color" json:"color,omitempty"`
+	Layer Canvas_Layer `protobuf:"varint,2,opt,name=layer,proto3,enum=tests.enum.Canvas_Layer" json:"layer,omitempty"`
+}
+
+func (x *Canvas) Reset() {
+	*x = Canvas{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_enum_enum_strings_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Canvas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Canvas) ProtoMessage() {}
+
+func (x *Canvas) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_enum_enum_strings_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Canvas.ProtoReflect.Descriptor instead.
+func (*Canvas) Descriptor() ([]byte, []int) {
+	return file_tests_enum_enum_strings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Canvas) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+func (x *Canvas) GetLayer() Canvas_Layer {
+	if x != nil {
+		return x.Layer
+	}
+	return Canvas_LAYER_BACKGROUND
+}
+
+var File_tests_enum_enum_strings_proto protoreflect.FileDescriptor
+
+var file_tests_enum_enum_strings_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2f, 0x65, 0x6e, 0x75,
+	0x6d, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0a, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x1a, 0x0e, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x01, 0x0a, 0x06,
+	0x43, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x65, 0x6e,
+	0x75, 0x6d, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12,
+	0x2e, 0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x76,
+	0x61, 0x73, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22,
+	0x62, 0x0a, 0x05, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x10, 0x4c, 0x41, 0x59, 0x45,
+	0x52, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x47, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x00, 0x1a, 0x11,
+	0xca, 0xb5, 0x03, 0x0d, 0x82, 0x02, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x27, 0x0a, 0x10, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x45, 0x47,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x82, 0x02, 0x0a,
+	0x66, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0x07, 0xca, 0xb5, 0x03, 0x03,
+	0x88, 0x02, 0x01, 0x2a, 0x85, 0x01, 0x0a, 0x05, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x29, 0x0a,
+	0x11, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x1a, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x82, 0x02, 0x0b, 0x75, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x09, 0x43, 0x4f, 0x4c, 0x4f,
+	0x52, 0x5f, 0x52, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x0a, 0xca, 0xb5, 0x03, 0x06, 0x82, 0x02, 0x03,
+	0x72, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0b, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x47, 0x52, 0x45,
+	0x45, 0x4e, 0x10, 0x02, 0x1a, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x82, 0x02, 0x05, 0x67, 0x72, 0x65,
+	0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x42, 0x4c, 0x55, 0x45,
+	0x10, 0x03, 0x1a, 0x07, 0xca, 0xb5, 0x03, 0x03, 0x88, 0x02, 0x01, 0x2a, 0x5d, 0x0a, 0x05, 0x53,
+	0x68, 0x61, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0c, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x43, 0x49,
+	0x52, 0x43, 0x4c, 0x45, 0x10, 0x00, 0x1a, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0x82, 0x02, 0x06, 0x63,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0c, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x53,
+	0x51, 0x55, 0x41, 0x52, 0x45, 0x10, 0x01, 0x1a, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0x82, 0x02, 0x06,
+	0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x1a, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x04, 0x46, 0x6f,
+	0x72, 0x6d, 0xf2, 0x01, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x65,
+	0x6e, 0x75, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_enum_enum_strings_proto_rawDescOnce sync.Once
+	file_tests_enum_enum_strings_proto_rawDescData = file_tests_enum_enum_strings_proto_rawDesc
+)
+
+func file_tests_enum_enum_strings_proto_rawDescGZIP() []byte {
+	file_tests_enum_enum_strings_proto_rawDescOnce.Do(func() {
+		file_tests_enum_enum_strings_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_enum_enum_strings_proto_rawDescData)
+	})
+	return file_tests_enum_enum_strings_proto_rawDescData
+}
+
+var file_tests_enum_enum_strings_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_tests_enum_enum_strings_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_tests_enum_enum_strings_proto_goTypes = []any{
+	(Color)(0),        // 0: tests.enum.Color
+	(Form)(0),         // 1: tests.enum.Shape
+	(Canvas_Layer)(0), // 2: tests.enum.Canvas.Layer
+	(*Canvas)(nil),    // 3: tests.enum.Canvas
+}
+var file_tests_enum_enum_strings_proto_depIdxs = []int32{
+	0, // 0: tests.enum.Canvas.color:type_name -> tests.enum.Color
+	2, // 1: tests.enum.Canvas.layer:type_name -> tests.enum.Canvas.Layer
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tests_enum_enum_strings_proto_init() }
+func file_tests_enum_enum_strings_proto_init() {
+	if File_tests_enum_enum_strings_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_enum_enum_strings_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Canvas); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_enum_enum_strings_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_enum_enum_strings_proto_goTypes,
+		DependencyIndexes: file_tests_enum_enum_strings_proto_depIdxs,
+		EnumInfos:         file_tests_enum_enum_strings_proto_enumTypes,
+		MessageInfos:      file_tests_enum_enum_strings_proto_msgTypes,
+	}.Build()
+	File_tests_enum_enum_strings_proto = out.File
+	file_tests_enum_enum_strings_proto_rawDesc = nil
+	file_tests_enum_enum_strings_proto_goTypes = nil
+	file_tests_enum_enum_strings_proto_depIdxs = nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (x Color) MarshalText() ([]byte, error) {
+	if s, ok := Color_name[int32(x)]; ok {
+		return []byte(s), nil
+	}
+	return nil, fmt.Errorf("invalid Color value: %d", int32(x))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (x *Color) UnmarshalText(b []byte) error {
+	if v, ok := Color_value[string(b)]; ok {
+		*x = Color(v)
+		return nil
+	}
+	if v := x.Descriptor().Values().ByName(protoreflect.Name(b)); v != nil {
+		*x = Color(v.Number())
+		return nil
+	}
+	return fmt.Errorf("invalid Color value: %q", b)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Values without a name are encoded as numbers.
+func (x Color) MarshalJSON() ([]byte, error) {
+	if s, ok := Color_name[int32(x)]; ok {
+		return json.Marshal(s)
+	}
+	return json.Marshal(int32(x))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts strings, as with UnmarshalText, and numbers.
+func (x *Color) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var n int32
+	if err := json.Unmarshal(b, &n); err == nil {
+		*x = Color(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (x Canvas_Layer) MarshalText() ([]byte, error) {
+	if s, ok := Canvas_Layer_name[int32(x)]; ok {
+		return []byte(s), nil
+	}
+	return nil, fmt.Errorf("invalid Canvas_Layer value: %d", int32(x))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (x *Canvas_Layer) UnmarshalText(b []byte) error {
+	if v, ok := Canvas_Layer_value[string(b)]; ok {
+		*x = Canvas_Layer(v)
+		return nil
+	}
+	if v := x.Descriptor().Values().ByName(protoreflect.Name(b)); v != nil {
+		*x = Canvas_Layer(v.Number())
+		return nil
+	}
+	return fmt.Errorf("invalid Canvas_Layer value: %q", b)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Values without a name are encoded as numbers.
+func (x Canvas_Layer) MarshalJSON() ([]byte, error) {
+	if s, ok := Canvas_Layer_name[int32(x)]; ok {
+		return json.Marshal(s)
+	}
+	return json.Marshal(int32(x))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts strings, as with UnmarshalText, and numbers.
+func (x *Canvas_Layer) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var n int32
+	if err := json.Unmarshal(b, &n); err == nil {
+		*x = Canvas_Layer(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
@@ -1,9 +1,14 @@
 package enum
 
 import (
+	"encoding"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"testing"
 
+	"google.golang.org/protobuf/reflect/protoreflect"
+
 	"github.com/alta/protopatch/tests"
 )
 
@@ -143,3 +148,177 @@ func TestDefaultStringerEnum(t *testing.T) {
 		t.Errorf("%T(%d) incorrect original string %q != %q", e, e, got, want)
 	}
 }
+
+func TestEnumStrings(t *testing.T) {
+	tests.ValidateEnum(t, Color(0), Color_name, Color_value)
+	for _, tt := range []struct {
+		enum fmt.Stringer
+		want string
+	}{
+		{Color_COLOR_UNSPECIFIED, "unspecified"},
+		{Color_COLOR_RED, "red"},
+		{Color_COLOR_BLUE, "COLOR_BLUE"},
+		{Color(99), "99"},
+		{Canvas_LAYER_FOREGROUND, "foreground"},
+	} {
+		if got := tt.enum.String(); got != tt.want {
+			t.Errorf("%T(%v).String() = %q, want %q", tt.enum, tt.enum, got, tt.want)
+		}
+	}
+	if got, want := Form_SHAPE_SQUARE.Label(), "square"; got != want {
+		t.Errorf("Form_SHAPE_SQUARE.Label() = %q, want %q", got, want)
+	}
+	if got, want := Color_COLOR_RED.Descriptor().Values().ByNumber(1).Name(), protoreflect.Name("COLOR_RED"); got != want {
+		t.Errorf("proto value name = %q, want %q", got, want)
+	}
+}
+
+func TestEnumTextMarshaler(t *testing.T) {
+	var _ encoding.TextMarshaler = Color(0)
+	var _ encoding.TextUnmarshaler = (*Color)(nil)
+	b, err := Color_COLOR_GREEN.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), "green"; got != want {
+		t.Errorf("MarshalText() = %q, want %q", got, want)
+	}
+	if _, err := Color(99).MarshalText(); err == nil {
+		t.Error("MarshalText() of invalid value: expected error")
+	}
+	for _, s := range []string{"green", "COLOR_GREEN"} {
+		var c Color
+		if err := c.UnmarshalText([]byte(s)); err != nil {
+			t.Errorf("UnmarshalText(%q): %v", s, err)
+		}
+		if c != Color_COLOR_GREEN {
+			t.Errorf("UnmarshalText(%q) = %v, want %v", s, c, Color_COLOR_GREEN)
+		}
+	}
+	var c Color
+	if err := c.UnmarshalText([]byte("purple")); err == nil {
+		t.Error("UnmarshalText(purple): expected error")
+	}
+	var l Canvas_Layer
+	if err := l.UnmarshalText([]byte("foreground")); err != nil || l != Canvas_LAYER_FOREGROUND {
+		t.Errorf("UnmarshalText(foreground) = %v, %v", l, err)
+	}
+}
+
+func TestEnumJSONMarshaler(t *testing.T) {
+	var _ json.Marshaler = Color(0)
+	var _ json.Unmarshaler = (*Color)(nil)
+	type doc struct {
+		Color  Color            `json:"color"`
+		Colors []Color          `json:"colors"`
+		Layer  Canvas_Layer     `json:"layer"`
+		Other  Color            `json:"other"`
+		Map    map[string]Color `json:"map"`
+	}
+	in := doc{
+		Color:  Color_COLOR_RED,
+		Colors: []Color{Color_COLOR_GREEN, Color_COLOR_BLUE},
+		Layer:  Canvas_LAYER_FOREGROUND,
+		Other:  Color(99),
+		Map:    map[string]Color{"a": Color_COLOR_UNSPECIFIED},
+	}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"color":"red","colors":["green","COLOR_BLUE"],"layer":"foreground","other":99,"map":{"a":"unspecified"}}`; got != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+	var out doc
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("json.Unmarshal() = %+v, want %+v", out, in)
+	}
+	var c Color
+	if err := json.Unmarshal([]byte(`"COLOR_GREEN"`), &c); err != nil || c != Color_COLOR_GREEN {
+		t.Errorf("json.Unmarshal(COLOR_GREEN) = %v, %v", c, err)
+	}
+	if err := json.Unmarshal([]byte(`"purple"`), &c); err == nil {
+		t.Error("json.Unmarshal(purple): expected error")
+	}
+}
+
+func TestProto2EnumJSONMarshaler(t *testing.T) {
+	var _ json.Unmarshaler = (*Weekday)(nil)
+	in := &struct {
+		Weekday Weekday         `json:"weekday"`
+		Season  Calendar_Season `json:"season"`
+	}{Weekday_WEEKDAY_TUESDAY, Calendar_SEASON_SUMMER}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"weekday":"tuesday","season":"SEASON_SUMMER"}`; got != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+	var w Weekday
+	for _, s := range []string{`"monday"`, `"WEEKDAY_MONDAY"`, `1`} {
+		if err := json.Unmarshal([]byte(s), &w); err != nil || w != Weekday_WEEKDAY_MONDAY {
+			t.Errorf("json.Unmarshal(%s) = %v, %v", s, w, err)
+		}
+	}
+}
+
+func TestEnumHelpers(t *testing.T) {
+	if got, want := StatusValues(), []Status{StatusUnknown, StatusActive, StatusDisabled}; !reflect.DeepEqual(got, want) {
+		t.Errorf("StatusValues() = %v, want %v", got, want)
+	}
+	if !StatusActive.IsValid() || Status(99).IsValid() {
+		t.Error("IsValid() returned an unexpected result")
+	}
+	for _, s := range []string{"active", "STATUS_ACTIVE"} {
+		if got, err := ParseStatus(s); err != nil || got != StatusActive {
+			t.Errorf("ParseStatus(%q) = %v, %v", s, got, err)
+		}
+	}
+	if _, err := ParseStatus("STATUS_UNKNOWN_VALUE"); err == nil {
+		t.Error("ParseStatus(STATUS_UNKNOWN_VALUE): expected error")
+	}
+	if got, want := AccountRoleValues(), []AccountRole{Account_ROLE_USER, Account_ROLE_ADMIN}; !reflect.DeepEqual(got, want) {
+		t.Errorf("AccountRoleValues() = %v, want %v", got, want)
+	}
+	if got, err := ParseAccountRole("ROLE_ADMIN"); err != nil || got != Account_ROLE_ADMIN {
+		t.Errorf("ParseAccountRole(ROLE_ADMIN) = %v, %v", got, err)
+	}
+}
+
+func TestEnumAliases(t *testing.T) {
+	if PriorityLow != PriorityMinor {
+		t.Errorf("PriorityLow = %d, want %d", PriorityLow, PriorityMinor)
+	}
+	tests := []struct {
+		v    Priority
+		want string
+	}{
+		{PriorityLow, "minor"},
+		{PriorityHigh, "PRIORITY_HIGH"},
+	}
+	for _, tt := range tests {
+		if got := tt.v.String(); got != tt.want {
+			t.Errorf("%d.String() = %q, want %q", tt.v, got, tt.want)
+		}
+		if b, err := tt.v.MarshalText(); err != nil || string(b) != tt.want {
+			t.Errorf("%d.MarshalText() = %q, %v, want %q", tt.v, b, err, tt.want)
+		}
+	}
+	if got, want := PriorityValues(), []Priority{PriorityUnspecified, PriorityMinor, PriorityHigh}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PriorityValues() = %v, want %v", got, want)
+	}
+	for _, s := range []string{"minor", "PRIORITY_LOW", "PRIORITY_MINOR"} {
+		if got, err := ParsePriority(s); err != nil || got != PriorityMinor {
+			t.Errorf("ParsePriority(%q) = %v, %v", s, got, err)
+		}
+	}
+	for _, s := range []string{"PRIORITY_HIGH", "PriorityHigh"} {
+		if got, err := ParsePriority(s); err != nil || got != PriorityHigh {
+			t.Errorf("ParsePriority(%q) = %v, %v", s, got, err)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/file/file_registry.proto
+
+package file
+
+import (
+	_ "github.com/alta/protopatch/patch/gopb"
+	protopatch "github.com/alta/protopatch/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Kind should be registered as Kind.
+type Kind int32
+
+const (
+	KindUnknown          Kind = 0
+	KindDeviceKindSensor Kind = 1
+)
+
+// Enum value maps for DeviceKind.
+var (
+	Kind_name = map[int32]string{
+		0: "DEVICE_KIND_UNKNOWN",
+		1: "DEVICE_KIND_SENSOR",
+	}
+	Kind_value = map[string]int32{
+		"DEVICE_KIND_UNKNOWN": 0,
+		"DEVICE_KIND_SENSOR":  1,
+	}
+)
+
+func (x Kind) Enum() *Kind {
+	p := new(Kind)
+	*p = x
+	return p
+}
+
+func (x Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_file_file_registry_proto_enumTypes[0].Descriptor()
+}
+
+func (Kind) Type() protoreflect.EnumType {
+	return &file_tests_file_file_registry_proto_enumTypes[0]
+}
+
+func (x Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeviceKind.Descriptor instead.
+func (Kind) EnumDescriptor() ([]byte, []int) {
+	return file_tests_file_file_registry_proto_rawDescGZIP(), []int{0}
+}
+
+// Device should be registered as Device.
+type Device struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceID    string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Addr:
+	//
+	//	*Device_IpAddr
+	//	*Device_HostUrl
+	Addr isDevice_Addr `protobuf_oneof:"address"`
+	Port *int32        `protobuf:"varint,5,opt,name=port,proto3,oneof" json:"port,omitempty"`
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_file_file_registry_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_file_file_registry_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_tests_file_file_registry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Device) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *Device) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (m *Device) GetAddr() isDevice_Addr {
+	if m != nil {
+		return m.Addr
+	}
+	return nil
+}
+
+func (x *Device) GetIPAddr() string {
+	if x, ok := x.GetAddr().(*Device_IPAddr); ok {
+		return x.IPAddr
+	}
+	return ""
+}
+
+func (x *Device) GetHostURL() string {
+	if x, ok := x.GetAddr().(*Device_HostURL); ok {
+		return x.HostURL
+	}
+	return ""
+}
+
+func (x *Device) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+type isDevice_Addr interface {
+	isDevice_Addr()
+}
+
+type Device_IPAddr struct {
+	IPAddr string `protobuf:"bytes,3,opt,name=ip_addr,json=ipAddr,proto3,oneof"`
+}
+
+type Device_HostURL struct {
+	HostURL string `protobuf:"bytes,4,opt,name=host_url,json=hostUrl,proto3,oneof"`
+}
+
+func (*Device_IPAddr) isDevice_Addr() {}
+
+func (*Device_HostURL) isDevice_Addr() {}
+
+var File_tests_file_file_registry_proto protoreflect.FileDescriptor
+
+var file_tests_file_file_registry_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x0e, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbd, 0x01, 0x0a,
+	0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x07, 0x69,
+	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
+	0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1b, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x68, 0x6f, 0x73, 0x74,
+	0x55, 0x72, 0x6c, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x01, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0a, 0xca, 0xb5, 0x03, 0x06, 0x0a, 0x04, 0x41,
+	0x64, 0x64, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x2a, 0x5c, 0x0a, 0x0a,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x13, 0x44, 0x45,
+	0x56, 0x49, 0x43, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x4b, 0x69, 0x6e, 0x64, 0x55,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x4e, 0x53, 0x4f, 0x52, 0x10, 0x01, 0x1a, 0x0a,
+	0xca, 0xb5, 0x03, 0x06, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x42, 0x33, 0xca, 0xb5, 0x03, 0x02,
+	0x08, 0x01, 0xd2, 0xb5, 0x03, 0x02, 0x48, 0x01, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_file_file_registry_proto_rawDescOnce sync.Once
+	file_tests_file_file_registry_proto_rawDescData = file_tests_file_file_registry_proto_rawDesc
+)
+
+func file_tests_file_file_registry_proto_rawDescGZIP() []byte {
+	file_tests_file_file_registry_proto_rawDescOnce.Do(func() {
+		file_tests_file_file_registry_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_file_file_registry_proto_rawDescData)
+	})
+	return file_tests_file_file_registry_proto_rawDescData
+}
+
+var file_tests_file_file_registry_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_file_file_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_tests_file_file_registry_proto_goTypes = []any{
+	(Kind)(0),      // 0: tests.file.DeviceKind
+	(*Device)(nil), // 1: tests.file.Device
+}
+var file_tests_file_file_registry_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_tests_file_file_registry_proto_init() }
+func file_tests_file_file_registry_proto_init() {
+	if File_tests_file_file_registry_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_file_file_registry_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Device); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_tests_file_file_registry_proto_msgTypes[0].OneofWrappers = []any{
+		(*Device_IPAddr)(nil),
+		(*Device_HostURL)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_file_file_registry_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_file_file_registry_proto_goTypes,
+		DependencyIndexes: file_tests_file_file_registry_proto_depIdxs,
+		EnumInfos:         file_tests_file_file_registry_proto_enumTypes,
+		MessageInfos:      file_tests_file_file_registry_proto_msgTypes,
+	}.Build()
+	File_tests_file_file_registry_proto = out.File
+	file_tests_file_file_registry_proto_rawDesc = nil
+	file_tests_file_file_registry_proto_goTypes = nil
+	file_tests_file_file_registry_proto_depIdxs = nil
+}
+
+// Register patched Go names with the protopatch runtime.
+func init() {
+	protopatch.Register(protopatch.Names{
+		"tests.file.Device":              "Device",
+		"tests.file.Device.address":      "Addr",
+		"tests.file.Device.device_id":    "DeviceID",
+		"tests.file.Device.name":         "DisplayName",
+		"tests.file.Device.ip_addr":      "IPAddr",
+		"tests.file.Device.host_url":     "HostURL",
+		"tests.file.Device.port":         "Port",
+		"tests.file.DeviceKind":          "Kind",
+		"tests.file.DEVICE_KIND_UNKNOWN": "KindUnknown",
+		"tests.file.DEVICE_KIND_SENSOR":  "KindDeviceKindSensor",
+	})
+}
@@ -0,0 +1,353 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/file/file_renames.proto
+
+package file
+
+import (
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PbStatus should be renamed to PbStatus, with a PbStatus alias.
+type PbStatus int32
+
+const (
+	// STATUS_UNKNOWN should be renamed to PbStatus_STATUS_UNKNOWN, with a PbStatus_STATUS_UNKNOWN alias.
+	PbStatus_STATUS_UNKNOWN PbStatus = 0
+	// STATUS_ACTIVE should be renamed to PbStatus_STATUS_ACTIVE, with a PbStatus_STATUS_ACTIVE alias.
+	PbStatus_STATUS_ACTIVE PbStatus = 1
+)
+
+// Enum value maps for Status.
+var (
+	PbStatus_name = map[int32]string{
+		0: "STATUS_UNKNOWN",
+		1: "STATUS_ACTIVE",
+	}
+	PbStatus_value = map[string]int32{
+		"STATUS_UNKNOWN": 0,
+		"STATUS_ACTIVE":  1,
+	}
+)
+
+func (x PbStatus) Enum() *PbStatus {
+	p := new(PbStatus)
+	*p = x
+	return p
+}
+
+func (x PbStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PbStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_file_file_renames_proto_enumTypes[0].Descriptor()
+}
+
+func (PbStatus) Type() protoreflect.EnumType {
+	return &file_tests_file_file_renames_proto_enumTypes[0]
+}
+
+func (x PbStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (PbStatus) EnumDescriptor() ([]byte, []int) {
+	return file_tests_file_file_renames_proto_rawDescGZIP(), []int{0}
+}
+
+// PbAccount should be renamed to PbAccount, with an PbAccount alias.
+type PbAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *PbAccount_Settings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	Status   PbStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=tests.file.Status" json:"status,omitempty"`
+}
+
+func (x *PbAccount) Reset() {
+	*x = PbAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_file_file_renames_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PbAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PbAccount) ProtoMessage() {}
+
+func (x *PbAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_file_file_renames_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*PbAccount) Descriptor() ([]byte, []int) {
+	return file_tests_file_file_renames_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PbAccount) GetSettings() *PbAccount_Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *PbAccount) GetStatus() PbStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PbStatus_STATUS_UNKNOWN
+}
+
+// Person should be renamed to Person, with no alias.
+type Person struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Person) Reset() {
+	*x = Person{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_file_file_renames_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Person) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Person) ProtoMessage() {}
+
+func (x *Person) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_file_file_renames_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Person) Descriptor() ([]byte, []int) {
+	return file_tests_file_file_renames_proto_rawDescGZIP(), []int{1}
+}
+
+// Settings should be renamed to PbAccount_Settings, with an PbAccount_Settings alias.
+type PbAccount_Settings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Private bool `protobuf:"varint,1,opt,name=private,proto3" json:"private,omitempty"`
+}
+
+func (x *PbAccount_Settings) Reset() {
+	*x = PbAccount_Settings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_file_file_renames_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PbAccount_Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PbAccount_Settings) ProtoMessage() {}
+
+func (x *PbAccount_Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_file_file_renames_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account_Settings.ProtoReflect.Descriptor instead.
+func (*PbAccount_Settings) Descriptor() ([]byte, []int) {
+	return file_tests_file_file_renames_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *PbAccount_Settings) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+var File_tests_file_file_renames_proto protoreflect.FileDescriptor
+
+var file_tests_file_file_renames_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0a, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x0e, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x01, 0x0a, 0x07,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x24, 0x0a,
+	0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x22, 0x19, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x0e,
+	0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x38, 0x00, 0x2a, 0x2f,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x42,
+	0x31, 0xd2, 0xb5, 0x03, 0x06, 0x2a, 0x02, 0x50, 0x62, 0x38, 0x01, 0x5a, 0x25, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x66, 0x69,
+	0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_file_file_renames_proto_rawDescOnce sync.Once
+	file_tests_file_file_renames_proto_rawDescData = file_tests_file_file_renames_proto_rawDesc
+)
+
+func file_tests_file_file_renames_proto_rawDescGZIP() []byte {
+	file_tests_file_file_renames_proto_rawDescOnce.Do(func() {
+		file_tests_file_file_renames_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_file_file_renames_proto_rawDescData)
+	})
+	return file_tests_file_file_renames_proto_rawDescData
+}
+
+var file_tests_file_file_renames_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_file_file_renames_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_tests_file_file_renames_proto_goTypes = []any{
+	(PbStatus)(0),              // 0: tests.file.Status
+	(*PbAccount)(nil),          // 1: tests.file.Account
+	(*Person)(nil),             // 2: tests.file.Profile
+	(*PbAccount_Settings)(nil), // 3: tests.file.Account.Settings
+}
+var file_tests_file_file_renames_proto_depIdxs = []int32{
+	3, // 0: tests.file.Account.settings:type_name -> tests.file.Account.Settings
+	0, // 1: tests.file.Account.status:type_name -> tests.file.Status
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tests_file_file_renames_proto_init() }
+func file_tests_file_file_renames_proto_init() {
+	if File_tests_file_file_renames_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_file_file_renames_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*PbAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_file_file_renames_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Person); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_file_file_renames_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*PbAccount_Settings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_file_file_renames_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_file_file_renames_proto_goTypes,
+		DependencyIndexes: file_tests_file_file_renames_proto_depIdxs,
+		EnumInfos:         file_tests_file_file_renames_proto_enumTypes,
+		MessageInfos:      file_tests_file_file_renames_proto_msgTypes,
+	}.Build()
+	File_tests_file_file_renames_proto = out.File
+	file_tests_file_file_renames_proto_rawDesc = nil
+	file_tests_file_file_renames_proto_goTypes = nil
+	file_tests_file_file_renames_proto_depIdxs = nil
+}
+
+// Aliases for renamed types.
+type (
+	// Status is an alias for PbStatus.
+	Status = PbStatus
+	// Account is an alias for PbAccount.
+	Account = PbAccount
+	// Account_Settings is an alias for PbAccount_Settings.
+	Account_Settings = PbAccount_Settings
+)
+
+// Aliases for renamed values.
+const (
+	// Status_STATUS_UNKNOWN is an alias for PbStatus_STATUS_UNKNOWN.
+	Status_STATUS_UNKNOWN = PbStatus_STATUS_UNKNOWN
+	// Status_STATUS_ACTIVE is an alias for PbStatus_STATUS_ACTIVE.
+	Status_STATUS_ACTIVE = PbStatus_STATUS_ACTIVE
+)
@@ -0,0 +1,512 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/file/file_rules.proto
+
+package file
+
+import (
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PbColor should be renamed to PbColor.
+type PbColor int32
+
+const (
+	// COLOR_UNKNOWN should be renamed to PbColor_COLOR_UNKNOWN.
+	PbColor_COLOR_UNKNOWN PbColor = 0
+	// COLOR_RED should be renamed to PbColor_COLOR_RED.
+	PbColor_COLOR_RED PbColor = 1
+)
+
+// Enum value maps for Color.
+var (
+	PbColor_name = map[int32]string{
+		0: "COLOR_UNKNOWN",
+		1: "COLOR_RED",
+	}
+	PbColor_value = map[string]int32{
+		"COLOR_UNKNOWN": 0,
+		"COLOR_RED":     1,
+	}
+)
+
+func (x PbColor) Enum() *PbColor {
+	p := new(PbColor)
+	*p = x
+	return p
+}
+
+func (x PbColor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PbColor) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_file_file_rules_proto_enumTypes[0].Descriptor()
+}
+
+func (PbColor) Type() protoreflect.EnumType {
+	return &file_tests_file_file_rules_proto_enumTypes[0]
+}
+
+func (x PbColor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Color.Descriptor instead.
+func (PbColor) EnumDescriptor() ([]byte, []int) {
+	return file_tests_file_file_rules_proto_rawDescGZIP(), []int{0}
+}
+
+// User should be renamed to User.
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *User_Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Color   PbColor       `protobuf:"varint,2,opt,name=color,proto3,enum=tests.file.Color" json:"color,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_file_file_rules_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_file_file_rules_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserV1.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_tests_file_file_rules_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetProfile() *User_Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *User) GetColor() PbColor {
+	if x != nil {
+		return x.Color
+	}
+	return PbColor_COLOR_UNKNOWN
+}
+
+// CreateUserReq should be renamed to CreateUserReq.
+type CreateUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *CreateUserReq) Reset() {
+	*x = CreateUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_file_file_rules_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserReq) ProtoMessage() {}
+
+func (x *CreateUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_file_file_rules_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserReq) Descriptor() ([]byte, []int) {
+	return file_tests_file_file_rules_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateUserReq) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// RequestLog should not be renamed.
+type RequestLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RequestLog) Reset() {
+	*x = RequestLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_file_file_rules_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestLog) ProtoMessage() {}
+
+func (x *RequestLog) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_file_file_rules_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestLog.ProtoReflect.Descriptor instead.
+func (*RequestLog) Descriptor() ([]byte, []int) {
+	return file_tests_file_file_rules_proto_rawDescGZIP(), []int{2}
+}
+
+// ExplicitName should be renamed to ExplicitName.
+type ExplicitName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Detail *ExplicitName_Detail `protobuf:"bytes,1,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *ExplicitName) Reset() {
+	*x = ExplicitName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_file_file_rules_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplicitName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplicitName) ProtoMessage() {}
+
+func (x *ExplicitName) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_file_file_rules_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplicitRequest.ProtoReflect.Descriptor instead.
+func (*ExplicitName) Descriptor() ([]byte, []int) {
+	return file_tests_file_file_rules_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExplicitName) GetDetail() *ExplicitName_Detail {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+// ProfileV1 should be renamed to User_Profile.
+type User_Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *User_Profile) Reset() {
+	*x = User_Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_file_file_rules_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User_Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User_Profile) ProtoMessage() {}
+
+func (x *User_Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_file_file_rules_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserV1_ProfileV1.ProtoReflect.Descriptor instead.
+func (*User_Profile) Descriptor() ([]byte, []int) {
+	return file_tests_file_file_rules_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// DetailV1 should be renamed to ExplicitName_Detail.
+type ExplicitName_Detail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExplicitName_Detail) Reset() {
+	*x = ExplicitName_Detail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_file_file_rules_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplicitName_Detail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplicitName_Detail) ProtoMessage() {}
+
+func (x *ExplicitName_Detail) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_file_file_rules_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplicitRequest_DetailV1.ProtoReflect.Descriptor instead.
+func (*ExplicitName_Detail) Descriptor() ([]byte, []int) {
+	return file_tests_file_file_rules_proto_rawDescGZIP(), []int{3, 0}
+}
+
+var File_tests_file_file_rules_proto protoreflect.FileDescriptor
+
+var file_tests_file_file_rules_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x76, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x56, 0x31, 0x12, 0x36, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x56, 0x31, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x1a, 0x0b, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56,
+	0x31, 0x22, 0x3b, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x0c,
+	0x0a, 0x0a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x22, 0x6f, 0x0a, 0x0f,
+	0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3c, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x56, 0x31, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0x0a, 0x0a,
+	0x08, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x56, 0x31, 0x3a, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a,
+	0x0c, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x2a, 0x29, 0x0a,
+	0x05, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4c,
+	0x4f, 0x52, 0x5f, 0x52, 0x45, 0x44, 0x10, 0x01, 0x42, 0x59, 0xd2, 0xb5, 0x03, 0x2e, 0x42, 0x06,
+	0x08, 0x01, 0x5a, 0x02, 0x56, 0x31, 0x42, 0x1c, 0x08, 0x01, 0xa2, 0x01, 0x0d, 0x5e, 0x28, 0x2e,
+	0x2a, 0x29, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x24, 0xaa, 0x01, 0x07, 0x24, 0x7b, 0x31,
+	0x7d, 0x52, 0x65, 0x71, 0x42, 0x06, 0x10, 0x01, 0x62, 0x02, 0x50, 0x62, 0x5a, 0x25, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x66,
+	0x69, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_file_file_rules_proto_rawDescOnce sync.Once
+	file_tests_file_file_rules_proto_rawDescData = file_tests_file_file_rules_proto_rawDesc
+)
+
+func file_tests_file_file_rules_proto_rawDescGZIP() []byte {
+	file_tests_file_file_rules_proto_rawDescOnce.Do(func() {
+		file_tests_file_file_rules_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_file_file_rules_proto_rawDescData)
+	})
+	return file_tests_file_file_rules_proto_rawDescData
+}
+
+var file_tests_file_file_rules_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_file_file_rules_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_tests_file_file_rules_proto_goTypes = []any{
+	(PbColor)(0),                // 0: tests.file.Color
+	(*User)(nil),                // 1: tests.file.UserV1
+	(*CreateUserReq)(nil),       // 2: tests.file.CreateUserRequest
+	(*RequestLog)(nil),          // 3: tests.file.RequestLog
+	(*ExplicitName)(nil),        // 4: tests.file.ExplicitRequest
+	(*User_Profile)(nil),        // 5: tests.file.UserV1.ProfileV1
+	(*ExplicitName_Detail)(nil), // 6: tests.file.ExplicitRequest.DetailV1
+}
+var file_tests_file_file_rules_proto_depIdxs = []int32{
+	5, // 0: tests.file.UserV1.profile:type_name -> tests.file.UserV1.ProfileV1
+	0, // 1: tests.file.UserV1.color:type_name -> tests.file.Color
+	1, // 2: tests.file.CreateUserRequest.user:type_name -> tests.file.UserV1
+	6, // 3: tests.file.ExplicitRequest.detail:type_name -> tests.file.ExplicitRequest.DetailV1
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_tests_file_file_rules_proto_init() }
+func file_tests_file_file_rules_proto_init() {
+	if File_tests_file_file_rules_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_file_file_rules_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_file_file_rules_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_file_file_rules_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_file_file_rules_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ExplicitName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_file_file_rules_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*User_Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_file_file_rules_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ExplicitName_Detail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_file_file_rules_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_file_file_rules_proto_goTypes,
+		DependencyIndexes: file_tests_file_file_rules_proto_depIdxs,
+		EnumInfos:         file_tests_file_file_rules_proto_enumTypes,
+		MessageInfos:      file_tests_file_file_rules_proto_msgTypes,
+	}.Build()
+	File_tests_file_file_rules_proto = out.File
+	file_tests_file_file_rules_proto_rawDesc = nil
+	file_tests_file_file_rules_proto_goTypes = nil
+	file_tests_file_file_rules_proto_depIdxs = nil
+}
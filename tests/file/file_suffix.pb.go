@@ -0,0 +1,252 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/file/file_suffix.proto
+
+package file
+
+import (
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Kind should be renamed to RequestMsg_Kind.
+type RequestMsg_Kind int32
+
+const (
+	RequestMsg_KIND_UNKNOWN RequestMsg_Kind = 0
+)
+
+// Enum value maps for Request_Kind.
+var (
+	RequestMsg_Kind_name = map[int32]string{
+		0: "KIND_UNKNOWN",
+	}
+	RequestMsg_Kind_value = map[string]int32{
+		"KIND_UNKNOWN": 0,
+	}
+)
+
+func (x RequestMsg_Kind) Enum() *RequestMsg_Kind {
+	p := new(RequestMsg_Kind)
+	*p = x
+	return p
+}
+
+func (x RequestMsg_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RequestMsg_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_file_file_suffix_proto_enumTypes[0].Descriptor()
+}
+
+func (RequestMsg_Kind) Type() protoreflect.EnumType {
+	return &file_tests_file_file_suffix_proto_enumTypes[0]
+}
+
+func (x RequestMsg_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Request_Kind.Descriptor instead.
+func (RequestMsg_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_tests_file_file_suffix_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// RequestMsg should be renamed to RequestMsg.
+type RequestMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind RequestMsg_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=tests.file.Request_Kind" json:"kind,omitempty"`
+}
+
+func (x *RequestMsg) Reset() {
+	*x = RequestMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_file_file_suffix_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMsg) ProtoMessage() {}
+
+func (x *RequestMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_file_file_suffix_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*RequestMsg) Descriptor() ([]byte, []int) {
+	return file_tests_file_file_suffix_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RequestMsg) GetKind() RequestMsg_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return RequestMsg_KIND_UNKNOWN
+}
+
+// Reply should be renamed to Reply.
+type Reply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Reply) Reset() {
+	*x = Reply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_file_file_suffix_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reply) ProtoMessage() {}
+
+func (x *Reply) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_file_file_suffix_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Reply) Descriptor() ([]byte, []int) {
+	return file_tests_file_file_suffix_proto_rawDescGZIP(), []int{1}
+}
+
+var File_tests_file_file_suffix_proto protoreflect.FileDescriptor
+
+var file_tests_file_file_suffix_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x22, 0x18, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x0c, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x22, 0x17, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x3a, 0x0b, 0xca, 0xb5, 0x03, 0x07, 0x0a,
+	0x05, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x30, 0xd2, 0xb5, 0x03, 0x05, 0x32, 0x03, 0x4d, 0x73,
+	0x67, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
+	0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_file_file_suffix_proto_rawDescOnce sync.Once
+	file_tests_file_file_suffix_proto_rawDescData = file_tests_file_file_suffix_proto_rawDesc
+)
+
+func file_tests_file_file_suffix_proto_rawDescGZIP() []byte {
+	file_tests_file_file_suffix_proto_rawDescOnce.Do(func() {
+		file_tests_file_file_suffix_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_file_file_suffix_proto_rawDescData)
+	})
+	return file_tests_file_file_suffix_proto_rawDescData
+}
+
+var file_tests_file_file_suffix_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_file_file_suffix_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tests_file_file_suffix_proto_goTypes = []any{
+	(RequestMsg_Kind)(0), // 0: tests.file.Request.Kind
+	(*RequestMsg)(nil),   // 1: tests.file.Request
+	(*Reply)(nil),        // 2: tests.file.Response
+}
+var file_tests_file_file_suffix_proto_depIdxs = []int32{
+	0, // 0: tests.file.Request.kind:type_name -> tests.file.Request.Kind
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_tests_file_file_suffix_proto_init() }
+func file_tests_file_file_suffix_proto_init() {
+	if File_tests_file_file_suffix_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_file_file_suffix_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_file_file_suffix_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Reply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_file_file_suffix_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_file_file_suffix_proto_goTypes,
+		DependencyIndexes: file_tests_file_file_suffix_proto_depIdxs,
+		EnumInfos:         file_tests_file_file_suffix_proto_enumTypes,
+		MessageInfos:      file_tests_file_file_suffix_proto_msgTypes,
+	}.Build()
+	File_tests_file_file_suffix_proto = out.File
+	file_tests_file_file_suffix_proto_rawDesc = nil
+	file_tests_file_file_suffix_proto_goTypes = nil
+	file_tests_file_file_suffix_proto_depIdxs = nil
+}
@@ -0,0 +1,192 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/file/file_tags.proto
+
+package file
+
+import (
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Tagged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" test:"default" yaml:"-"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" test:"default" yaml:"name"`
+	// Types that are assignable to Contents:
+	//
+	//	*Tagged_Text
+	Contents isTagged_Contents `protobuf_oneof:"contents"`
+}
+
+func (x *Tagged) Reset() {
+	*x = Tagged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_file_file_tags_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tagged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tagged) ProtoMessage() {}
+
+func (x *Tagged) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_file_file_tags_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tagged.ProtoReflect.Descriptor instead.
+func (*Tagged) Descriptor() ([]byte, []int) {
+	return file_tests_file_file_tags_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tagged) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Tagged) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *Tagged) GetContents() isTagged_Contents {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (x *Tagged) GetText() string {
+	if x, ok := x.GetContents().(*Tagged_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+type isTagged_Contents interface {
+	isTagged_Contents()
+}
+
+type Tagged_Text struct {
+	Text string `protobuf:"bytes,3,opt,name=text,proto3,oneof" test:"default" yaml:"-"`
+}
+
+func (*Tagged_Text) isTagged_Contents() {}
+
+var File_tests_file_file_tags_proto protoreflect.FileDescriptor
+
+var file_tests_file_file_tags_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x68, 0x0a, 0x06, 0x54, 0x61, 0x67, 0x67,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0xa2, 0x01, 0x0b, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x73, 0x42, 0x45, 0xd2, 0xb5, 0x03, 0x1a, 0xa2, 0x01, 0x17, 0x74, 0x65, 0x73, 0x74, 0x3a,
+	0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x2d, 0x22, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_tests_file_file_tags_proto_rawDescOnce sync.Once
+	file_tests_file_file_tags_proto_rawDescData = file_tests_file_file_tags_proto_rawDesc
+)
+
+func file_tests_file_file_tags_proto_rawDescGZIP() []byte {
+	file_tests_file_file_tags_proto_rawDescOnce.Do(func() {
+		file_tests_file_file_tags_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_file_file_tags_proto_rawDescData)
+	})
+	return file_tests_file_file_tags_proto_rawDescData
+}
+
+var file_tests_file_file_tags_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_tests_file_file_tags_proto_goTypes = []any{
+	(*Tagged)(nil), // 0: tests.file.Tagged
+}
+var file_tests_file_file_tags_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_tests_file_file_tags_proto_init() }
+func file_tests_file_file_tags_proto_init() {
+	if File_tests_file_file_tags_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_file_file_tags_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Tagged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_tests_file_file_tags_proto_msgTypes[0].OneofWrappers = []any{
+		(*Tagged_Text)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_file_file_tags_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_file_file_tags_proto_goTypes,
+		DependencyIndexes: file_tests_file_file_tags_proto_depIdxs,
+		MessageInfos:      file_tests_file_file_tags_proto_msgTypes,
+	}.Build()
+	File_tests_file_file_tags_proto = out.File
+	file_tests_file_file_tags_proto_rawDesc = nil
+	file_tests_file_file_tags_proto_goTypes = nil
+	file_tests_file_file_tags_proto_depIdxs = nil
+}
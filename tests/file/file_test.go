@@ -0,0 +1,136 @@
+package file
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/alta/protopatch/runtime"
+	"github.com/alta/protopatch/tests"
+)
+
+func TestPrefixedMessage(t *testing.T) {
+	m := &PbAccount{Settings: &PbAccount_Settings{Private: true}, Status: PbStatus_STATUS_ACTIVE}
+	tests.ValidateMessage(t, m)
+	var _ *PbAccount_Settings = m.GetSettings()
+	var _ PbStatus = m.GetStatus()
+}
+
+func TestPrefixedEnum(t *testing.T) {
+	tests.ValidateEnum(t, PbStatus(0), PbStatus_name, PbStatus_value)
+	enums := []PbStatus{
+		PbStatus_STATUS_UNKNOWN,
+		PbStatus_STATUS_ACTIVE,
+	}
+	for index, enum := range enums {
+		if got, want := enum, PbStatus(index); got != want {
+			t.Errorf("%T(%d) != %v", got, got, want)
+		}
+	}
+}
+
+func TestAliases(t *testing.T) {
+	var _ *PbAccount = &Account{}
+	var _ *PbAccount_Settings = &Account_Settings{}
+	var _ PbStatus = Status(0)
+	if got, want := Status_STATUS_UNKNOWN, PbStatus_STATUS_UNKNOWN; got != want {
+		t.Errorf("%T(%d) != %v", got, got, want)
+	}
+	if got, want := Status_STATUS_ACTIVE, PbStatus_STATUS_ACTIVE; got != want {
+		t.Errorf("%T(%d) != %v", got, got, want)
+	}
+	tests.ValidateMessage(t, &Person{})
+}
+
+func TestSuffixedMessage(t *testing.T) {
+	m := &RequestMsg{Kind: RequestMsg_KIND_UNKNOWN}
+	tests.ValidateMessage(t, m)
+	var _ RequestMsg_Kind = m.GetKind()
+	tests.ValidateMessage(t, &Reply{})
+}
+
+func TestFileTags(t *testing.T) {
+	m := &Tagged{}
+	tests.ValidateTag(t, m, "Value", "test", "default")
+	tests.ValidateTag(t, m, "Value", "yaml", "-")
+	tests.ValidateTag(t, m, "Name", "test", "default")
+	tests.ValidateTag(t, m, "Name", "yaml", "name")
+	f, _ := reflect.TypeOf(Tagged_Text{}).FieldByName("Text")
+	if got, want := f.Tag.Get("yaml"), "-"; got != want {
+		t.Errorf("Tagged_Text.Text tag `yaml` = %q, expected %q", got, want)
+	}
+}
+
+func TestFileGetters(t *testing.T) {
+	m := &Getters{
+		Value:    "value",
+		ID:       42,
+		Title:    "title",
+		Contents: &Getters_Text{Text: "text"},
+	}
+	tests.ValidateMessage(t, m)
+	if got, want := m.FetchValue(), "value"; got != want {
+		t.Errorf("FetchValue() = %q, expected %q", got, want)
+	}
+	if got, want := m.FetchID(), int32(42); got != want {
+		t.Errorf("FetchID() = %d, expected %d", got, want)
+	}
+	if got, want := m.LookupTitle(), "title"; got != want {
+		t.Errorf("LookupTitle() = %q, expected %q", got, want)
+	}
+	if got, want := m.FetchText(), "text"; got != want {
+		t.Errorf("FetchText() = %q, expected %q", got, want)
+	}
+	var _ isGetters_Contents = m.FetchContents()
+}
+
+func TestRenameRules(t *testing.T) {
+	m := &User{Profile: &User_Profile{}, Color: PbColor_COLOR_RED}
+	tests.ValidateMessage(t, m)
+	var _ *User_Profile = m.GetProfile()
+	var _ PbColor = m.GetColor()
+	tests.ValidateMessage(t, &CreateUserReq{User: m})
+	tests.ValidateMessage(t, &RequestLog{})
+	tests.ValidateMessage(t, &ExplicitName{Detail: &ExplicitName_Detail{}})
+	tests.ValidateEnum(t, PbColor(0), PbColor_name, PbColor_value)
+	if got, want := PbColor_COLOR_UNKNOWN, PbColor(0); got != want {
+		t.Errorf("%T(%d) != %v", got, got, want)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	md := (&Device{}).ProtoReflect().Descriptor()
+	fields := md.Fields()
+	tests := []struct {
+		name protoreflect.FullName
+		want string
+	}{
+		{md.FullName(), "Device"},
+		{md.Oneofs().ByName("address").FullName(), "Addr"},
+		{fields.ByName("device_id").FullName(), "DeviceID"},
+		{fields.ByName("name").FullName(), "DisplayName"},
+		{fields.ByName("ip_addr").FullName(), "IPAddr"},
+		{Kind(0).Descriptor().FullName(), "Kind"},
+		{Kind(0).Descriptor().Values().ByNumber(0).FullName(), "KindUnknown"},
+		{Kind(0).Descriptor().Values().ByNumber(1).FullName(), "KindDeviceKindSensor"},
+	}
+	for _, tt := range tests {
+		if got := runtime.GoName(tt.name); got != tt.want {
+			t.Errorf("runtime.GoName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	// Map each field descriptor to its Go struct field.
+	rt := reflect.TypeOf(Device{})
+	for _, name := range []protoreflect.Name{"device_id", "name", "port"} {
+		fd := fields.ByName(name)
+		if _, ok := rt.FieldByName(runtime.FieldGoName(fd)); !ok {
+			t.Errorf("runtime.FieldGoName(%q) = %q, not a field of %s", fd.FullName(), runtime.FieldGoName(fd), rt)
+		}
+	}
+	fd := fields.ByName("host_url")
+	if _, ok := reflect.TypeOf(Device_HostURL{}).FieldByName(runtime.FieldGoName(fd)); !ok {
+		t.Errorf("runtime.FieldGoName(%q) = %q, not a field of the oneof wrapper", fd.FullName(), runtime.FieldGoName(fd))
+	}
+}
@@ -0,0 +1,296 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/lint/lint_oneof_wrappers.proto
+
+package lint
+
+import (
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Pet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//
+	//	*Pet_DogId
+	//	*Pet_CatUrl
+	Kind isPetKind `protobuf_oneof:"kind"`
+}
+
+func (x *Pet) Reset() {
+	*x = Pet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_lint_lint_oneof_wrappers_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pet) ProtoMessage() {}
+
+func (x *Pet) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_lint_lint_oneof_wrappers_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pet.ProtoReflect.Descriptor instead.
+func (*Pet) Descriptor() ([]byte, []int) {
+	return file_tests_lint_lint_oneof_wrappers_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *Pet) GetKind() isPetKind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *Pet) GetDogID() string {
+	if x, ok := x.GetKind().(*PetDogID); ok {
+		return x.DogID
+	}
+	return ""
+}
+
+func (x *Pet) GetCatURL() string {
+	if x, ok := x.GetKind().(*PetCatURL); ok {
+		return x.CatURL
+	}
+	return ""
+}
+
+type isPetKind interface {
+	isPetKind()
+}
+
+type PetDogID struct {
+	// dog_id wrapper should lint to PetDogID.
+	DogID string `protobuf:"bytes,1,opt,name=dog_id,json=dogId,proto3,oneof"`
+}
+
+type PetCatURL struct {
+	// cat_url wrapper should lint to PetCatURL.
+	CatURL string `protobuf:"bytes,2,opt,name=cat_url,json=catUrl,proto3,oneof"`
+}
+
+func (*PetDogID) isPetKind() {}
+
+func (*PetCatURL) isPetKind() {}
+
+type Figure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//
+	//	*Shape_Circle
+	//	*Shape_Square
+	Kind isFigureKind `protobuf_oneof:"contents"`
+}
+
+func (x *Figure) Reset() {
+	*x = Figure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_lint_lint_oneof_wrappers_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Figure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Figure) ProtoMessage() {}
+
+func (x *Figure) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_lint_lint_oneof_wrappers_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shape.ProtoReflect.Descriptor instead.
+func (*Figure) Descriptor() ([]byte, []int) {
+	return file_tests_lint_lint_oneof_wrappers_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *Figure) GetKind() isFigureKind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *Figure) GetCircle() int32 {
+	if x, ok := x.GetKind().(*FigureCircle); ok {
+		return x.Circle
+	}
+	return 0
+}
+
+func (x *Figure) GetBox() int32 {
+	if x, ok := x.GetKind().(*FigureBox); ok {
+		return x.Box
+	}
+	return 0
+}
+
+type isFigureKind interface {
+	isFigureKind()
+}
+
+type FigureCircle struct {
+	// circle wrapper should be renamed to FigureCircle.
+	Circle int32 `protobuf:"varint,1,opt,name=circle,proto3,oneof"`
+}
+
+type FigureBox struct {
+	// square wrapper should be renamed to FigureBox.
+	Box int32 `protobuf:"varint,2,opt,name=square,proto3,oneof"`
+}
+
+func (*FigureCircle) isFigureKind() {}
+
+func (*FigureBox) isFigureKind() {}
+
+var File_tests_lint_lint_oneof_wrappers_proto protoreflect.FileDescriptor
+
+var file_tests_lint_lint_oneof_wrappers_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6c, 0x69, 0x6e, 0x74, 0x2f, 0x6c, 0x69, 0x6e,
+	0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6c, 0x69,
+	0x6e, 0x74, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x41, 0x0a, 0x03, 0x50, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x64, 0x6f, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x64, 0x6f, 0x67,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x63, 0x61, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x63, 0x61, 0x74, 0x55, 0x72, 0x6c, 0x42, 0x06, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x6c, 0x0a, 0x05, 0x53, 0x68, 0x61, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x06, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x06, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x71, 0x75, 0x61,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03,
+	0x42, 0x6f, 0x78, 0x48, 0x00, 0x52, 0x06, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x3a, 0x0c, 0xca,
+	0xb5, 0x03, 0x08, 0x0a, 0x06, 0x46, 0x69, 0x67, 0x75, 0x72, 0x65, 0x42, 0x16, 0x0a, 0x08, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0a, 0xca, 0xb5, 0x03, 0x06, 0x0a, 0x04, 0x4b,
+	0x69, 0x6e, 0x64, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x04, 0x08, 0x01, 0x38, 0x01, 0x5a, 0x25, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f,
+	0x6c, 0x69, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_lint_lint_oneof_wrappers_proto_rawDescOnce sync.Once
+	file_tests_lint_lint_oneof_wrappers_proto_rawDescData = file_tests_lint_lint_oneof_wrappers_proto_rawDesc
+)
+
+func file_tests_lint_lint_oneof_wrappers_proto_rawDescGZIP() []byte {
+	file_tests_lint_lint_oneof_wrappers_proto_rawDescOnce.Do(func() {
+		file_tests_lint_lint_oneof_wrappers_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_lint_lint_oneof_wrappers_proto_rawDescData)
+	})
+	return file_tests_lint_lint_oneof_wrappers_proto_rawDescData
+}
+
+var file_tests_lint_lint_oneof_wrappers_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tests_lint_lint_oneof_wrappers_proto_goTypes = []any{
+	(*Pet)(nil),    // 0: tests.lint.Pet
+	(*Figure)(nil), // 1: tests.lint.Shape
+}
+var file_tests_lint_lint_oneof_wrappers_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_tests_lint_lint_oneof_wrappers_proto_init() }
+func file_tests_lint_lint_oneof_wrappers_proto_init() {
+	if File_tests_lint_lint_oneof_wrappers_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_lint_lint_oneof_wrappers_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Pet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_lint_lint_oneof_wrappers_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Figure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_tests_lint_lint_oneof_wrappers_proto_msgTypes[0].OneofWrappers = []any{
+		(*PetDogID)(nil),
+		(*PetCatURL)(nil),
+	}
+	file_tests_lint_lint_oneof_wrappers_proto_msgTypes[1].OneofWrappers = []any{
+		(*FigureCircle)(nil),
+		(*FigureBox)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_lint_lint_oneof_wrappers_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_lint_lint_oneof_wrappers_proto_goTypes,
+		DependencyIndexes: file_tests_lint_lint_oneof_wrappers_proto_depIdxs,
+		MessageInfos:      file_tests_lint_lint_oneof_wrappers_proto_msgTypes,
+	}.Build()
+	File_tests_lint_lint_oneof_wrappers_proto = out.File
+	file_tests_lint_lint_oneof_wrappers_proto_rawDesc = nil
+	file_tests_lint_lint_oneof_wrappers_proto_goTypes = nil
+	file_tests_lint_lint_oneof_wrappers_proto_depIdxs = nil
+}
@@ -92,3 +92,21 @@ func TestEmbedLintedField(t *testing.T) {
 		t.Errorf("invalid EmbedLintedFieldTest.APIPath: expected '%s', got '%s'", apiPath, m.APIPath)
 	}
 }
+
+func TestOneofWrappers(t *testing.T) {
+	m := &Pet{Kind: &PetDogID{DogID: "rex"}}
+	tests.ValidateMessage(t, m)
+	var _ isPetKind = &PetDogID{}
+	var _ isPetKind = &PetCatURL{}
+	var _ string = m.GetDogID()
+	var _ string = m.GetCatURL()
+}
+
+func TestRenamedOneofWrappers(t *testing.T) {
+	m := &Figure{Kind: &FigureBox{Box: 4}}
+	tests.ValidateMessage(t, m)
+	var _ isFigureKind = &FigureCircle{}
+	var _ isFigureKind = &FigureBox{}
+	var _ isFigureKind = m.GetKind()
+	var _ int32 = m.GetBox()
+}
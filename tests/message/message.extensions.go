@@ -14,3 +14,19 @@ type Uint64 uint64
 
 type String string
 type Strings []string
+
+type UserID string
+
+type Score int64
+
+type Totals map[string]int64
+
+// Shade is a Color with domain-specific methods.
+type Shade Color
+
+// IsPrimary reports whether s is a primary color.
+func (s Shade) IsPrimary() bool {
+	return Color(s) == Color_COLOR_RED
+}
+
+type Shades []Color
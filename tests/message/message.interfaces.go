@@ -0,0 +1,18 @@
+package message
+
+// Identifier is implemented by messages with an ID.
+type Identifier interface {
+	GetID() int64
+}
+
+// Namer is implemented by messages with a settable name.
+type Namer interface {
+	GetName() String
+	SetName(String)
+}
+
+// Resetter is implemented by types with a Reset method that can fail.
+// Messages do not implement Resetter, as their Reset method has no result.
+type Resetter interface {
+	Reset() error
+}
@@ -0,0 +1,409 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/message/message_constructors.proto
+
+package message
+
+import (
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Account struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       int64             `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name     String            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email    *string           `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Tags     []string          `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Labels   map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Settings *Account_Settings `protobuf:"bytes,6,opt,name=settings,proto3" json:"settings,omitempty"`
+	// Types that are assignable to Contact:
+	//
+	//	*Account_Phone
+	//	*Account_Fax
+	Contact isAccount_Contact `protobuf_oneof:"contact"`
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_message_message_constructors_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_message_message_constructors_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_tests_message_message_constructors_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Account) GetName() String {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *Account) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Account) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Account) GetSettings() *Account_Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (m *Account) GetContact() isAccount_Contact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (x *Account) GetPhone() string {
+	if x, ok := x.GetContact().(*Account_Phone); ok {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Account) GetFacsimile() string {
+	if x, ok := x.GetContact().(*Account_Facsimile); ok {
+		return x.Facsimile
+	}
+	return ""
+}
+
+type isAccount_Contact interface {
+	isAccount_Contact()
+}
+
+type Account_Phone struct {
+	Phone string `protobuf:"bytes,7,opt,name=phone,proto3,oneof"`
+}
+
+type Account_Facsimile struct {
+	Facsimile string `protobuf:"bytes,8,opt,name=fax,proto3,oneof"`
+}
+
+func (*Account_Phone) isAccount_Contact() {}
+
+func (*Account_Facsimile) isAccount_Contact() {}
+
+type Account_Settings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *Account_Settings) Reset() {
+	*x = Account_Settings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_message_message_constructors_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account_Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account_Settings) ProtoMessage() {}
+
+func (x *Account_Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_message_message_constructors_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account_Settings.ProtoReflect.Descriptor instead.
+func (*Account_Settings) Descriptor() ([]byte, []int) {
+	return file_tests_message_message_constructors_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Account_Settings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+var File_tests_message_message_constructors_proto protoreflect.FileDescriptor
+
+var file_tests_message_message_constructors_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x03, 0x0a, 0x07, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a,
+	0x02, 0x49, 0x44, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xca, 0xb5,
+	0x03, 0x08, 0x1a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0x3a, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x12, 0x23, 0x0a, 0x03, 0x66, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca,
+	0xb5, 0x03, 0x0b, 0x0a, 0x09, 0x46, 0x61, 0x63, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x65, 0x48, 0x00,
+	0x52, 0x03, 0x66, 0x61, 0x78, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x2c, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x06, 0xca, 0xb5, 0x03, 0x02, 0x60, 0x01, 0x3a, 0x06,
+	0xca, 0xb5, 0x03, 0x02, 0x60, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x2a, 0x5a, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_message_message_constructors_proto_rawDescOnce sync.Once
+	file_tests_message_message_constructors_proto_rawDescData = file_tests_message_message_constructors_proto_rawDesc
+)
+
+func file_tests_message_message_constructors_proto_rawDescGZIP() []byte {
+	file_tests_message_message_constructors_proto_rawDescOnce.Do(func() {
+		file_tests_message_message_constructors_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_message_message_constructors_proto_rawDescData)
+	})
+	return file_tests_message_message_constructors_proto_rawDescData
+}
+
+var file_tests_message_message_constructors_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_tests_message_message_constructors_proto_goTypes = []any{
+	(*Account)(nil),          // 0: tests.message.Account
+	nil,                      // 1: tests.message.Account.LabelsEntry
+	(*Account_Settings)(nil), // 2: tests.message.Account.Settings
+}
+var file_tests_message_message_constructors_proto_depIdxs = []int32{
+	1, // 0: tests.message.Account.labels:type_name -> tests.message.Account.LabelsEntry
+	2, // 1: tests.message.Account.settings:type_name -> tests.message.Account.Settings
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tests_message_message_constructors_proto_init() }
+func file_tests_message_message_constructors_proto_init() {
+	if File_tests_message_message_constructors_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_message_message_constructors_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_message_message_constructors_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Account_Settings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_tests_message_message_constructors_proto_msgTypes[0].OneofWrappers = []any{
+		(*Account_Phone)(nil),
+		(*Account_Facsimile)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_message_message_constructors_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_message_message_constructors_proto_goTypes,
+		DependencyIndexes: file_tests_message_message_constructors_proto_depIdxs,
+		MessageInfos:      file_tests_message_message_constructors_proto_msgTypes,
+	}.Build()
+	File_tests_message_message_constructors_proto = out.File
+	file_tests_message_message_constructors_proto_rawDesc = nil
+	file_tests_message_message_constructors_proto_goTypes = nil
+	file_tests_message_message_constructors_proto_depIdxs = nil
+}
+
+// AccountOption is an option for NewAccount.
+type AccountOption func(*Account)
+
+// NewAccount returns a new Account with opts applied.
+func NewAccount(opts ...AccountOption) *Account {
+	x := &Account{}
+	for _, opt := range opts {
+		opt(x)
+	}
+	return x
+}
+
+// WithAccountID sets the ID field of a Account.
+func WithAccountID(v int64) AccountOption {
+	return func(x *Account) {
+		x.ID = v
+	}
+}
+
+// WithAccountName sets the Name field of a Account.
+func WithAccountName(v String) AccountOption {
+	return func(x *Account) {
+		x.Name = v
+	}
+}
+
+// WithAccountEmail sets the Email field of a Account.
+func WithAccountEmail(v string) AccountOption {
+	return func(x *Account) {
+		x.Email = &v
+	}
+}
+
+// WithAccountTags sets the Tags field of a Account.
+func WithAccountTags(v []string) AccountOption {
+	return func(x *Account) {
+		x.Tags = v
+	}
+}
+
+// WithAccountLabels sets the Labels field of a Account.
+func WithAccountLabels(v map[string]string) AccountOption {
+	return func(x *Account) {
+		x.Labels = v
+	}
+}
+
+// WithAccountSettings sets the Settings field of a Account.
+func WithAccountSettings(v *Account_Settings) AccountOption {
+	return func(x *Account) {
+		x.Settings = v
+	}
+}
+
+// WithAccountPhone sets the Phone field of a Account.
+func WithAccountPhone(v string) AccountOption {
+	return func(x *Account) {
+		x.Contact = &Account_Phone{Phone: v}
+	}
+}
+
+// WithAccountFacsimile sets the Facsimile field of a Account.
+func WithAccountFacsimile(v string) AccountOption {
+	return func(x *Account) {
+		x.Contact = &Account_Facsimile{Facsimile: v}
+	}
+}
+
+// Account_SettingsOption is an option for NewAccount_Settings.
+type Account_SettingsOption func(*Account_Settings)
+
+// NewAccount_Settings returns a new Account_Settings with opts applied.
+func NewAccount_Settings(opts ...Account_SettingsOption) *Account_Settings {
+	x := &Account_Settings{}
+	for _, opt := range opts {
+		opt(x)
+	}
+	return x
+}
+
+// WithAccount_SettingsEnabled sets the Enabled field of a Account_Settings.
+func WithAccount_SettingsEnabled(v bool) Account_SettingsOption {
+	return func(x *Account_Settings) {
+		x.Enabled = v
+	}
+}
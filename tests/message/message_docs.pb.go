@@ -0,0 +1,405 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/message/message_docs.proto
+
+package message
+
+import (
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DocumentKind has a leading comment.
+//
+// The zero value is unspecified.
+type DocumentKind int32
+
+const (
+	// DocumentKind_DOCUMENT_KIND_UNSPECIFIED is the zero value.
+	DocumentKind_DOCUMENT_KIND_UNSPECIFIED DocumentKind = 0
+	// Deprecated: Use DOCUMENT_KIND_NOTE instead.
+	DocumentKind_DOCUMENT_KIND_MEMO DocumentKind = 1
+	DocumentKind_DOCUMENT_KIND_NOTE DocumentKind = 2
+)
+
+// Enum value maps for DocumentKind.
+var (
+	DocumentKind_name = map[int32]string{
+		0: "DOCUMENT_KIND_UNSPECIFIED",
+		1: "DOCUMENT_KIND_MEMO",
+		2: "DOCUMENT_KIND_NOTE",
+	}
+	DocumentKind_value = map[string]int32{
+		"DOCUMENT_KIND_UNSPECIFIED": 0,
+		"DOCUMENT_KIND_MEMO":        1,
+		"DOCUMENT_KIND_NOTE":        2,
+	}
+)
+
+func (x DocumentKind) Enum() *DocumentKind {
+	p := new(DocumentKind)
+	*p = x
+	return p
+}
+
+func (x DocumentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DocumentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_message_message_docs_proto_enumTypes[0].Descriptor()
+}
+
+func (DocumentKind) Type() protoreflect.EnumType {
+	return &file_tests_message_message_docs_proto_enumTypes[0]
+}
+
+func (x DocumentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DocumentKind.Descriptor instead.
+func (DocumentKind) EnumDescriptor() ([]byte, []int) {
+	return file_tests_message_message_docs_proto_rawDescGZIP(), []int{0}
+}
+
+// Document is a message with a doc comment replaced by an option.
+type Document struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Title is the title of the document.
+	//
+	// It is never empty.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Body has a leading comment.
+	//
+	// The body is formatted with Markdown.
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"` // Body has a trailing comment.
+	// Summary has a leading comment.
+	//
+	// Deprecated: Use Body instead.
+	Summary string `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Subtitle has a doc comment without a leading comment.
+	Subtitle string `protobuf:"bytes,4,opt,name=subtitle,proto3" json:"subtitle,omitempty"`
+
+	Author string `protobuf:"bytes,7,opt,name=author,proto3" json:"author,omitempty"`
+	// Types that are assignable to Source:
+	//
+	//	*Document_Url
+	//	*Document_Path
+	Source isDocument_Source `protobuf_oneof:"source"`
+}
+
+func (x *Document) Reset() {
+	*x = Document{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_message_message_docs_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document) ProtoMessage() {}
+
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_message_message_docs_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_tests_message_message_docs_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Document) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Document) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// Deprecated: Use Body instead.
+func (x *Document) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *Document) GetSubtitle() string {
+	if x != nil {
+		return x.Subtitle
+	}
+	return ""
+}
+
+func (x *Document) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (m *Document) GetSource() isDocument_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *Document) GetUrl() string {
+	if x, ok := x.GetSource().(*Document_Url); ok {
+		return x.Url
+	}
+	return ""
+}
+
+// Deprecated: Use Url instead.
+func (x *Document) GetPath() string {
+	if x, ok := x.GetSource().(*Document_Path); ok {
+		return x.Path
+	}
+	return ""
+}
+
+type isDocument_Source interface {
+	isDocument_Source()
+}
+
+type Document_Url struct {
+	// URL has a leading comment.
+	//
+	// The URL is absolute.
+	Url string `protobuf:"bytes,5,opt,name=url,proto3,oneof"`
+}
+
+type Document_Path struct {
+	// Deprecated: Use Url instead.
+	Path string `protobuf:"bytes,6,opt,name=path,proto3,oneof"`
+}
+
+func (*Document_Url) isDocument_Source() {}
+
+func (*Document_Path) isDocument_Source() {}
+
+// LegacyDocument is a deprecated message.
+//
+// Deprecated: Use Document instead.
+type LegacyDocument struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LegacyDocument) Reset() {
+	*x = LegacyDocument{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_message_message_docs_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegacyDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyDocument) ProtoMessage() {}
+
+func (x *LegacyDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_message_message_docs_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegacyDocument.ProtoReflect.Descriptor instead.
+func (*LegacyDocument) Descriptor() ([]byte, []int) {
+	return file_tests_message_message_docs_proto_rawDescGZIP(), []int{1}
+}
+
+var File_tests_message_message_docs_proto protoreflect.FileDescriptor
+
+var file_tests_message_message_docs_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x86, 0x04, 0x0a, 0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x54,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3e, 0xca,
+	0xb5, 0x03, 0x3a, 0xe2, 0x03, 0x37, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x0a, 0x0a, 0x49, 0x74, 0x20, 0x69, 0x73,
+	0x20, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2b, 0xca, 0xb5, 0x03, 0x27, 0xea, 0x03, 0x24, 0x54, 0x68, 0x65, 0x20, 0x62,
+	0x6f, 0x64, 0x79, 0x20, 0x69, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x4d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x2e, 0x52,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xca, 0xb5, 0x03, 0x14, 0xf2, 0x03, 0x11, 0x55,
+	0x73, 0x65, 0x20, 0x42, 0x6f, 0x64, 0x79, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x2e,
+	0x18, 0x01, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x58, 0x0a, 0x08, 0x73,
+	0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xca,
+	0xb5, 0x03, 0x38, 0xe2, 0x03, 0x35, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x20, 0x68,
+	0x61, 0x73, 0x20, 0x61, 0x20, 0x64, 0x6f, 0x63, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x61, 0x20, 0x6c, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x08, 0x73, 0x75, 0x62,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xca, 0xb5, 0x03, 0x03, 0xe2, 0x03, 0x00, 0x52, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0xea, 0x03, 0x14, 0x54, 0x68, 0x65, 0x20,
+	0x55, 0x52, 0x4c, 0x20, 0x69, 0x73, 0x20, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x2e,
+	0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xca, 0xb5, 0x03, 0x13, 0xf2, 0x03, 0x10, 0x55, 0x73,
+	0x65, 0x20, 0x55, 0x72, 0x6c, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x2e, 0x18, 0x01,
+	0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x3a, 0x46, 0xca, 0xb5, 0x03, 0x42, 0xe2, 0x03,
+	0x3f, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x64, 0x6f,
+	0x63, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x61, 0x6e, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x5a, 0x0a, 0x0e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x48, 0xca, 0xb5,
+	0x03, 0x42, 0xea, 0x03, 0x27, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0xf2, 0x03, 0x15, 0x55,
+	0x73, 0x65, 0x20, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x6e, 0x73, 0x74,
+	0x65, 0x61, 0x64, 0x2e, 0x18, 0x01, 0x2a, 0xf0, 0x01, 0x0a, 0x0c, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x5f, 0x0a, 0x19, 0x44, 0x4f, 0x43, 0x55, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x40, 0xca, 0xb5, 0x03, 0x3c, 0xe2, 0x03, 0x39, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x5f, 0x44, 0x4f, 0x43, 0x55,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x7a, 0x65, 0x72,
+	0x6f, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x12, 0x40, 0x0a, 0x12, 0x44, 0x4f, 0x43, 0x55,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x10, 0x01,
+	0x1a, 0x28, 0xca, 0xb5, 0x03, 0x22, 0xf2, 0x03, 0x1f, 0x55, 0x73, 0x65, 0x20, 0x44, 0x4f, 0x43,
+	0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x20,
+	0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x2e, 0x08, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x4f,
+	0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x45,
+	0x10, 0x02, 0x1a, 0x25, 0xca, 0xb5, 0x03, 0x21, 0xea, 0x03, 0x1e, 0x54, 0x68, 0x65, 0x20, 0x7a,
+	0x65, 0x72, 0x6f, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69, 0x73, 0x20, 0x75, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2e, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_message_message_docs_proto_rawDescOnce sync.Once
+	file_tests_message_message_docs_proto_rawDescData = file_tests_message_message_docs_proto_rawDesc
+)
+
+func file_tests_message_message_docs_proto_rawDescGZIP() []byte {
+	file_tests_message_message_docs_proto_rawDescOnce.Do(func() {
+		file_tests_message_message_docs_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_message_message_docs_proto_rawDescData)
+	})
+	return file_tests_message_message_docs_proto_rawDescData
+}
+
+var file_tests_message_message_docs_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_message_message_docs_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tests_message_message_docs_proto_goTypes = []any{
+	(DocumentKind)(0),      // 0: tests.message.DocumentKind
+	(*Document)(nil),       // 1: tests.message.Document
+	(*LegacyDocument)(nil), // 2: tests.message.LegacyDocument
+}
+var file_tests_message_message_docs_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_tests_message_message_docs_proto_init() }
+func file_tests_message_message_docs_proto_init() {
+	if File_tests_message_message_docs_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_message_message_docs_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Document); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_message_message_docs_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*LegacyDocument); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_tests_message_message_docs_proto_msgTypes[0].OneofWrappers = []any{
+		(*Document_Url)(nil),
+		(*Document_Path)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_message_message_docs_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_message_message_docs_proto_goTypes,
+		DependencyIndexes: file_tests_message_message_docs_proto_depIdxs,
+		EnumInfos:         file_tests_message_message_docs_proto_enumTypes,
+		MessageInfos:      file_tests_message_message_docs_proto_msgTypes,
+	}.Build()
+	File_tests_message_message_docs_proto = out.File
+	file_tests_message_message_docs_proto_rawDesc = nil
+	file_tests_message_message_docs_proto_goTypes = nil
+	file_tests_message_message_docs_proto_depIdxs = nil
+}
@@ -0,0 +1,406 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/message/message_extension_accessors.proto
+
+package message
+
+import (
+	_ "github.com/alta/protopatch/patch/gopb"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Tier int32
+
+const (
+	Tier_TIER_FREE Tier = 0
+	Tier_TIER_PRO  Tier = 1
+)
+
+// Enum value maps for Tier.
+var (
+	Tier_name = map[int32]string{
+		0: "TIER_FREE",
+		1: "TIER_PRO",
+	}
+	Tier_value = map[string]int32{
+		"TIER_FREE": 0,
+		"TIER_PRO":  1,
+	}
+)
+
+func (x Tier) Enum() *Tier {
+	p := new(Tier)
+	*p = x
+	return p
+}
+
+func (x Tier) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Tier) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_message_message_extension_accessors_proto_enumTypes[0].Descriptor()
+}
+
+func (Tier) Type() protoreflect.EnumType {
+	return &file_tests_message_message_extension_accessors_proto_enumTypes[0]
+}
+
+func (x Tier) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Tier) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Tier(num)
+	return nil
+}
+
+// Deprecated: Use Tier.Descriptor instead.
+func (Tier) EnumDescriptor() ([]byte, []int) {
+	return file_tests_message_message_extension_accessors_proto_rawDescGZIP(), []int{0}
+}
+
+type ExtensionScope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExtensionScope) Reset() {
+	*x = ExtensionScope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_message_message_extension_accessors_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtensionScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionScope) ProtoMessage() {}
+
+func (x *ExtensionScope) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_message_message_extension_accessors_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionScope.ProtoReflect.Descriptor instead.
+func (*ExtensionScope) Descriptor() ([]byte, []int) {
+	return file_tests_message_message_extension_accessors_proto_rawDescGZIP(), []int{0}
+}
+
+var file_tests_message_message_extension_accessors_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*ExtendedMessage)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         105,
+		Name:          "tests.message.epsilon",
+		Tag:           "bytes,105,opt,name=epsilon",
+		Filename:      "tests/message/message_extension_accessors.proto",
+	},
+	{
+		ExtendedType:  (*ExtendedMessage)(nil),
+		ExtensionType: (*int64)(nil),
+		Field:         106,
+		Name:          "tests.message.zeta",
+		Tag:           "varint,106,opt,name=zeta",
+		Filename:      "tests/message/message_extension_accessors.proto",
+	},
+	{
+		ExtendedType:  (*ExtendedMessage)(nil),
+		ExtensionType: ([]string)(nil),
+		Field:         107,
+		Name:          "tests.message.eta",
+		Tag:           "bytes,107,rep,name=eta",
+		Filename:      "tests/message/message_extension_accessors.proto",
+	},
+	{
+		ExtendedType:  (*ExtendedMessage)(nil),
+		ExtensionType: (*Tier)(nil),
+		Field:         108,
+		Name:          "tests.message.theta",
+		Tag:           "varint,108,opt,name=theta,enum=tests.message.Tier",
+		Filename:      "tests/message/message_extension_accessors.proto",
+	},
+	{
+		ExtendedType:  (*ExtendedMessage)(nil),
+		ExtensionType: (*ExtendedMessage)(nil),
+		Field:         109,
+		Name:          "tests.message.iota",
+		Tag:           "bytes,109,opt,name=iota",
+		Filename:      "tests/message/message_extension_accessors.proto",
+	},
+	{
+		ExtendedType:  (*ExtendedMessage)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         110,
+		Name:          "tests.message.kappa",
+		Tag:           "bytes,110,opt,name=kappa",
+		Filename:      "tests/message/message_extension_accessors.proto",
+	},
+	{
+		ExtendedType:  (*ExtendedMessage)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         111,
+		Name:          "tests.message.lambda",
+		Tag:           "bytes,111,opt,name=lambda",
+		Filename:      "tests/message/message_extension_accessors.proto",
+	},
+	{
+		ExtendedType:  (*ExtendedMessage)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         112,
+		Name:          "tests.message.ExtensionScope.mu",
+		Tag:           "varint,112,opt,name=mu",
+		Filename:      "tests/message/message_extension_accessors.proto",
+	},
+}
+
+// Extension fields to ExtendedMessage.
+var (
+	// optional string epsilon = 105;
+	E_Epsilon = &file_tests_message_message_extension_accessors_proto_extTypes[0]
+	// optional int64 zeta = 106;
+	E_Zeta = &file_tests_message_message_extension_accessors_proto_extTypes[1]
+	// repeated string eta = 107;
+	E_Eta = &file_tests_message_message_extension_accessors_proto_extTypes[2]
+	// optional tests.message.Tier theta = 108;
+	E_Theta = &file_tests_message_message_extension_accessors_proto_extTypes[3]
+	// optional tests.message.ExtendedMessage iota = 109;
+	E_Iota = &file_tests_message_message_extension_accessors_proto_extTypes[4]
+	// optional string kappa = 110;
+	E_Kappa = &file_tests_message_message_extension_accessors_proto_extTypes[5]
+	// optional string lambda = 111;
+	ExtLambda = &file_tests_message_message_extension_accessors_proto_extTypes[6]
+	// optional int32 mu = 112;
+	E_ExtensionScope_Mu = &file_tests_message_message_extension_accessors_proto_extTypes[7]
+)
+
+var File_tests_message_message_extension_accessors_proto protoreflect.FileDescriptor
+
+var file_tests_message_message_extension_accessors_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x26, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x40, 0x0a, 0x0e, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32, 0x2e, 0x0a, 0x02, 0x6d, 0x75,
+	0x12, 0x1e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x70, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6d, 0x75, 0x2a, 0x23, 0x0a, 0x04, 0x54, 0x69,
+	0x65, 0x72, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x10,
+	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x10, 0x01, 0x3a,
+	0x38, 0x0a, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x69, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x3a, 0x3f, 0x0a, 0x04, 0x7a, 0x65, 0x74,
+	0x61, 0x12, 0x1e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xca, 0xb5, 0x03, 0x07, 0x1a, 0x05, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x52, 0x04, 0x7a, 0x65, 0x74, 0x61, 0x3a, 0x3f, 0x0a, 0x03, 0x65, 0x74,
+	0x61, 0x12, 0x1e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x6b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0x1a, 0x07, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x03, 0x65, 0x74, 0x61, 0x3a, 0x49, 0x0a, 0x05, 0x74,
+	0x68, 0x65, 0x74, 0x61, 0x12, 0x1e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x65, 0x72, 0x52,
+	0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x3a, 0x52, 0x0a, 0x04, 0x69, 0x6f, 0x74, 0x61, 0x12, 0x1e,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x6d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x6f, 0x74, 0x61, 0x3a, 0x3c, 0x0a, 0x05, 0x6b, 0x61,
+	0x70, 0x70, 0x61, 0x12, 0x1e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xb5, 0x03, 0x02, 0x68,
+	0x00, 0x52, 0x05, 0x6b, 0x61, 0x70, 0x70, 0x61, 0x3a, 0x47, 0x0a, 0x06, 0x6c, 0x61, 0x6d, 0x62,
+	0x64, 0x61, 0x12, 0x1e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0x0a, 0x09,
+	0x45, 0x78, 0x74, 0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x52, 0x06, 0x6c, 0x61, 0x6d, 0x62, 0x64,
+	0x61, 0x42, 0x30, 0xd2, 0xb5, 0x03, 0x02, 0x68, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65,
+}
+
+var (
+	file_tests_message_message_extension_accessors_proto_rawDescOnce sync.Once
+	file_tests_message_message_extension_accessors_proto_rawDescData = file_tests_message_message_extension_accessors_proto_rawDesc
+)
+
+func file_tests_message_message_extension_accessors_proto_rawDescGZIP() []byte {
+	file_tests_message_message_extension_accessors_proto_rawDescOnce.Do(func() {
+		file_tests_message_message_extension_accessors_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_message_message_extension_accessors_proto_rawDescData)
+	})
+	return file_tests_message_message_extension_accessors_proto_rawDescData
+}
+
+var file_tests_message_message_extension_accessors_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_message_message_extension_accessors_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_tests_message_message_extension_accessors_proto_goTypes = []any{
+	(Tier)(0),               // 0: tests.message.Tier
+	(*ExtensionScope)(nil),  // 1: tests.message.ExtensionScope
+	(*ExtendedMessage)(nil), // 2: tests.message.ExtendedMessage
+}
+var file_tests_message_message_extension_accessors_proto_depIdxs = []int32{
+	2,  // 0: tests.message.epsilon:extendee -> tests.message.ExtendedMessage
+	2,  // 1: tests.message.zeta:extendee -> tests.message.ExtendedMessage
+	2,  // 2: tests.message.eta:extendee -> tests.message.ExtendedMessage
+	2,  // 3: tests.message.theta:extendee -> tests.message.ExtendedMessage
+	2,  // 4: tests.message.iota:extendee -> tests.message.ExtendedMessage
+	2,  // 5: tests.message.kappa:extendee -> tests.message.ExtendedMessage
+	2,  // 6: tests.message.lambda:extendee -> tests.message.ExtendedMessage
+	2,  // 7: tests.message.ExtensionScope.mu:extendee -> tests.message.ExtendedMessage
+	0,  // 8: tests.message.theta:type_name -> tests.message.Tier
+	2,  // 9: tests.message.iota:type_name -> tests.message.ExtendedMessage
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	8,  // [8:10] is the sub-list for extension type_name
+	0,  // [0:8] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_tests_message_message_extension_accessors_proto_init() }
+func file_tests_message_message_extension_accessors_proto_init() {
+	if File_tests_message_message_extension_accessors_proto != nil {
+		return
+	}
+	file_tests_message_message_extensions_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_tests_message_message_extension_accessors_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtensionScope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_message_message_extension_accessors_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 8,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_message_message_extension_accessors_proto_goTypes,
+		DependencyIndexes: file_tests_message_message_extension_accessors_proto_depIdxs,
+		EnumInfos:         file_tests_message_message_extension_accessors_proto_enumTypes,
+		MessageInfos:      file_tests_message_message_extension_accessors_proto_msgTypes,
+		ExtensionInfos:    file_tests_message_message_extension_accessors_proto_extTypes,
+	}.Build()
+	File_tests_message_message_extension_accessors_proto = out.File
+	file_tests_message_message_extension_accessors_proto_rawDesc = nil
+	file_tests_message_message_extension_accessors_proto_goTypes = nil
+	file_tests_message_message_extension_accessors_proto_depIdxs = nil
+}
+
+// GetEpsilon returns the value of the tests.message.epsilon extension of m.
+func GetEpsilon(m *ExtendedMessage) string {
+	return proto.GetExtension(m, E_Epsilon).(string)
+}
+
+// SetEpsilon sets the value of the tests.message.epsilon extension of m.
+func SetEpsilon(m *ExtendedMessage, v string) {
+	proto.SetExtension(m, E_Epsilon, v)
+}
+
+// GetZeta returns the value of the tests.message.zeta extension of m.
+func GetZeta(m *ExtendedMessage) Score {
+	return Score(proto.GetExtension(m, E_Zeta).(int64))
+}
+
+// SetZeta sets the value of the tests.message.zeta extension of m.
+func SetZeta(m *ExtendedMessage, v Score) {
+	proto.SetExtension(m, E_Zeta, int64(v))
+}
+
+// GetEta returns the value of the tests.message.eta extension of m.
+func GetEta(m *ExtendedMessage) Strings {
+	return Strings(proto.GetExtension(m, E_Eta).([]string))
+}
+
+// SetEta sets the value of the tests.message.eta extension of m.
+func SetEta(m *ExtendedMessage, v Strings) {
+	proto.SetExtension(m, E_Eta, []string(v))
+}
+
+// GetTheta returns the value of the tests.message.theta extension of m.
+func GetTheta(m *ExtendedMessage) Tier {
+	return proto.GetExtension(m, E_Theta).(Tier)
+}
+
+// SetTheta sets the value of the tests.message.theta extension of m.
+func SetTheta(m *ExtendedMessage, v Tier) {
+	proto.SetExtension(m, E_Theta, v)
+}
+
+// GetIota returns the value of the tests.message.iota extension of m.
+func GetIota(m *ExtendedMessage) *ExtendedMessage {
+	return proto.GetExtension(m, E_Iota).(*ExtendedMessage)
+}
+
+// SetIota sets the value of the tests.message.iota extension of m.
+func SetIota(m *ExtendedMessage, v *ExtendedMessage) {
+	proto.SetExtension(m, E_Iota, v)
+}
+
+// GetExtLambda returns the value of the tests.message.lambda extension of m.
+func GetExtLambda(m *ExtendedMessage) string {
+	return proto.GetExtension(m, ExtLambda).(string)
+}
+
+// SetExtLambda sets the value of the tests.message.lambda extension of m.
+func SetExtLambda(m *ExtendedMessage, v string) {
+	proto.SetExtension(m, ExtLambda, v)
+}
+
+// GetExtensionScope_Mu returns the value of the tests.message.ExtensionScope.mu extension of m.
+func GetExtensionScope_Mu(m *ExtendedMessage) int32 {
+	return proto.GetExtension(m, E_ExtensionScope_Mu).(int32)
+}
+
+// SetExtensionScope_Mu sets the value of the tests.message.ExtensionScope.mu extension of m.
+func SetExtensionScope_Mu(m *ExtendedMessage, v int32) {
+	proto.SetExtension(m, E_ExtensionScope_Mu, v)
+}
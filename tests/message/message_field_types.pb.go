@@ -21,6 +21,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Color int32
+
+const (
+	Color_COLOR_UNSPECIFIED Color = 0
+	Color_COLOR_RED         Color = 1
+	Color_COLOR_GREEN       Color = 2
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0: "COLOR_UNSPECIFIED",
+		1: "COLOR_RED",
+		2: "COLOR_GREEN",
+	}
+	Color_value = map[string]int32{
+		"COLOR_UNSPECIFIED": 0,
+		"COLOR_RED":         1,
+		"COLOR_GREEN":       2,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_message_message_field_types_proto_enumTypes[0].Descriptor()
+}
+
+func (Color) Type() protoreflect.EnumType {
+	return &file_tests_message_message_field_types_proto_enumTypes[0]
+}
+
+func (x Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Color.Descriptor instead.
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return file_tests_message_message_field_types_proto_rawDescGZIP(), []int{0}
+}
+
 type MessageWithCustomTypes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -339,6 +388,152 @@ func (x *MessageWithCustomRepeatedType) GetRepeatedStringField() Strings {
 	return nil
 }
 
+type MessageWithCustomElemType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIds []UserID `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Scores  []Score  `protobuf:"varint,2,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *MessageWithCustomElemType) Reset() {
+	*x = MessageWithCustomElemType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_message_message_field_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageWithCustomElemType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageWithCustomElemType) ProtoMessage() {}
+
+func (x *MessageWithCustomElemType) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_message_message_field_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageWithCustomElemType.ProtoReflect.Descriptor instead.
+func (*MessageWithCustomElemType) Descriptor() ([]byte, []int) {
+	return file_tests_message_message_field_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MessageWithCustomElemType) GetUserIds() []UserID {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *MessageWithCustomElemType) GetScores() []Score {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type MessageWithCustomEnumTypes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Color         Shade  `protobuf:"varint,1,opt,name=color,proto3,enum=tests.message.Color" json:"color,omitempty"`
+	OptionalColor *Shade `protobuf:"varint,2,opt,name=optional_color,json=optionalColor,proto3,enum=tests.message.Color,oneof" json:"optional_color,omitempty"`
+	Colors        Shades `protobuf:"varint,3,rep,packed,name=colors,proto3,enum=tests.message.Color" json:"colors,omitempty"`
+	// Types that are assignable to Choice:
+	//
+	//	*MessageWithCustomEnumTypes_OneofColor
+	Choice isMessageWithCustomEnumTypes_Choice `protobuf_oneof:"choice"`
+}
+
+func (x *MessageWithCustomEnumTypes) Reset() {
+	*x = MessageWithCustomEnumTypes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_message_message_field_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageWithCustomEnumTypes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageWithCustomEnumTypes) ProtoMessage() {}
+
+func (x *MessageWithCustomEnumTypes) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_message_message_field_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageWithCustomEnumTypes.ProtoReflect.Descriptor instead.
+func (*MessageWithCustomEnumTypes) Descriptor() ([]byte, []int) {
+	return file_tests_message_message_field_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MessageWithCustomEnumTypes) GetColor() Shade {
+	if x != nil {
+		return x.Color
+	}
+	return Shade(Color_COLOR_UNSPECIFIED)
+}
+
+func (x *MessageWithCustomEnumTypes) GetOptionalColor() Shade {
+	if x != nil && x.OptionalColor != nil {
+		return *x.OptionalColor
+	}
+	return Shade(Color_COLOR_UNSPECIFIED)
+}
+
+func (x *MessageWithCustomEnumTypes) GetColors() Shades {
+	if x != nil {
+		return x.Colors
+	}
+	return nil
+}
+
+func (m *MessageWithCustomEnumTypes) GetChoice() isMessageWithCustomEnumTypes_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (x *MessageWithCustomEnumTypes) GetOneofColor() Shade {
+	if x, ok := x.GetChoice().(*MessageWithCustomEnumTypes_OneofColor); ok {
+		return x.OneofColor
+	}
+	return Shade(Color_COLOR_UNSPECIFIED)
+}
+
+type isMessageWithCustomEnumTypes_Choice interface {
+	isMessageWithCustomEnumTypes_Choice()
+}
+
+type MessageWithCustomEnumTypes_OneofColor struct {
+	OneofColor Shade `protobuf:"varint,4,opt,name=oneof_color,json=oneofColor,proto3,enum=tests.message.Color,oneof"`
+}
+
+func (*MessageWithCustomEnumTypes_OneofColor) isMessageWithCustomEnumTypes_Choice() {}
+
 var File_tests_message_message_field_types_proto protoreflect.FileDescriptor
 
 var file_tests_message_message_field_types_proto_rawDesc = []byte{
@@ -416,10 +611,41 @@ var file_tests_message_message_field_types_proto_rawDesc = []byte{
 	0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0x1a, 0x07, 0x53, 0x74, 0x72,
 	0x69, 0x6e, 0x67, 0x73, 0x52, 0x13, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x6b, 0x0a, 0x19, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x45, 0x6c,
+	0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0xd2, 0x02,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x12, 0x24, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03,
+	0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0xd2, 0x02, 0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0xc3, 0x02, 0x0a, 0x1a, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x57, 0x69, 0x74, 0x68, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x45, 0x6e, 0x75, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x42, 0x0b, 0xca, 0xb5, 0x03, 0x07,
+	0x1a, 0x05, 0x53, 0x68, 0x61, 0x64, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x4d,
+	0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x42, 0x0b, 0xca, 0xb5,
+	0x03, 0x07, 0x1a, 0x05, 0x53, 0x68, 0x61, 0x64, 0x65, 0x48, 0x01, 0x52, 0x0d, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a,
+	0x06, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x1a, 0x06, 0x53, 0x68, 0x61, 0x64, 0x65,
+	0x73, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x42, 0x0b, 0xca, 0xb5, 0x03, 0x07, 0x1a, 0x05, 0x53, 0x68, 0x61, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x42,
+	0x08, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x2a, 0x3e, 0x0a, 0x05,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x43,
+	0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x47, 0x52, 0x45, 0x45, 0x4e, 0x10, 0x02, 0x42, 0x2a, 0x5a, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -434,19 +660,27 @@ func file_tests_message_message_field_types_proto_rawDescGZIP() []byte {
 	return file_tests_message_message_field_types_proto_rawDescData
 }
 
-var file_tests_message_message_field_types_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_tests_message_message_field_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_message_message_field_types_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_tests_message_message_field_types_proto_goTypes = []any{
-	(*MessageWithCustomTypes)(nil),         // 0: tests.message.MessageWithCustomTypes
-	(*MessageWithOptionalCustomTypes)(nil), // 1: tests.message.MessageWithOptionalCustomTypes
-	(*MessageWithOneOfCustomType)(nil),     // 2: tests.message.MessageWithOneOfCustomType
-	(*MessageWithCustomRepeatedType)(nil),  // 3: tests.message.MessageWithCustomRepeatedType
+	(Color)(0),                             // 0: tests.message.Color
+	(*MessageWithCustomTypes)(nil),         // 1: tests.message.MessageWithCustomTypes
+	(*MessageWithOptionalCustomTypes)(nil), // 2: tests.message.MessageWithOptionalCustomTypes
+	(*MessageWithOneOfCustomType)(nil),     // 3: tests.message.MessageWithOneOfCustomType
+	(*MessageWithCustomRepeatedType)(nil),  // 4: tests.message.MessageWithCustomRepeatedType
+	(*MessageWithCustomElemType)(nil),      // 5: tests.message.MessageWithCustomElemType
+	(*MessageWithCustomEnumTypes)(nil),     // 6: tests.message.MessageWithCustomEnumTypes
 }
 var file_tests_message_message_field_types_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: tests.message.MessageWithCustomEnumTypes.color:type_name -> tests.message.Color
+	0, // 1: tests.message.MessageWithCustomEnumTypes.optional_color:type_name -> tests.message.Color
+	0, // 2: tests.message.MessageWithCustomEnumTypes.colors:type_name -> tests.message.Color
+	0, // 3: tests.message.MessageWithCustomEnumTypes.oneof_color:type_name -> tests.message.Color
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_tests_message_message_field_types_proto_init() }
@@ -503,24 +737,52 @@ func file_tests_message_message_field_types_proto_init() {
 				return nil
 			}
 		}
+		file_tests_message_message_field_types_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*MessageWithCustomElemType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_message_message_field_types_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*MessageWithCustomEnumTypes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_tests_message_message_field_types_proto_msgTypes[1].OneofWrappers = []any{}
 	file_tests_message_message_field_types_proto_msgTypes[2].OneofWrappers = []any{
 		(*MessageWithOneOfCustomType_StringField)(nil),
 		(*MessageWithOneOfCustomType_Int64Field)(nil),
 	}
+	file_tests_message_message_field_types_proto_msgTypes[5].OneofWrappers = []any{
+		(*MessageWithCustomEnumTypes_OneofColor)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tests_message_message_field_types_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_tests_message_message_field_types_proto_goTypes,
 		DependencyIndexes: file_tests_message_message_field_types_proto_depIdxs,
+		EnumInfos:         file_tests_message_message_field_types_proto_enumTypes,
 		MessageInfos:      file_tests_message_message_field_types_proto_msgTypes,
 	}.Build()
 	File_tests_message_message_field_types_proto = out.File
@@ -0,0 +1,284 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/message/message_map_types.proto
+
+package message
+
+import (
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MessageWithMapTypes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scores   map[UserID]Score    `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Totals   Totals              `protobuf:"bytes,2,rep,name=totals,proto3" json:"totals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Accounts map[UserID]*Account `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Names    map[int32]String    `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MessageWithMapTypes) Reset() {
+	*x = MessageWithMapTypes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_message_message_map_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageWithMapTypes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageWithMapTypes) ProtoMessage() {}
+
+func (x *MessageWithMapTypes) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_message_message_map_types_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageWithMapTypes.ProtoReflect.Descriptor instead.
+func (*MessageWithMapTypes) Descriptor() ([]byte, []int) {
+	return file_tests_message_message_map_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MessageWithMapTypes) GetScores() map[UserID]Score {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *MessageWithMapTypes) GetTotals() Totals {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *MessageWithMapTypes) GetAccounts() map[UserID]*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *MessageWithMapTypes) GetNames() map[int32]String {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+var File_tests_message_message_map_types_proto protoreflect.FileDescriptor
+
+var file_tests_message_message_map_types_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x8a, 0x05, 0x0a, 0x13, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x4d, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x15, 0xca, 0xb5, 0x03, 0x11, 0xc2, 0x02,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0xca, 0x02, 0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52,
+	0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x4d, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x1a, 0x06, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x5b, 0x0a,
+	0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x70, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0xc2, 0x02, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x52, 0x0a, 0x05, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0xca, 0x02,
+	0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x39,
+	0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38, 0x0a, 0x0a, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x3a, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x58, 0x01, 0x60, 0x01, 0x42, 0x2a, 0x5a,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_tests_message_message_map_types_proto_rawDescOnce sync.Once
+	file_tests_message_message_map_types_proto_rawDescData = file_tests_message_message_map_types_proto_rawDesc
+)
+
+func file_tests_message_message_map_types_proto_rawDescGZIP() []byte {
+	file_tests_message_message_map_types_proto_rawDescOnce.Do(func() {
+		file_tests_message_message_map_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_message_message_map_types_proto_rawDescData)
+	})
+	return file_tests_message_message_map_types_proto_rawDescData
+}
+
+var file_tests_message_message_map_types_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_tests_message_message_map_types_proto_goTypes = []any{
+	(*MessageWithMapTypes)(nil), // 0: tests.message.MessageWithMapTypes
+	nil,                         // 1: tests.message.MessageWithMapTypes.ScoresEntry
+	nil,                         // 2: tests.message.MessageWithMapTypes.TotalsEntry
+	nil,                         // 3: tests.message.MessageWithMapTypes.AccountsEntry
+	nil,                         // 4: tests.message.MessageWithMapTypes.NamesEntry
+	(*Account)(nil),             // 5: tests.message.Account
+}
+var file_tests_message_message_map_types_proto_depIdxs = []int32{
+	1, // 0: tests.message.MessageWithMapTypes.scores:type_name -> tests.message.MessageWithMapTypes.ScoresEntry
+	2, // 1: tests.message.MessageWithMapTypes.totals:type_name -> tests.message.MessageWithMapTypes.TotalsEntry
+	3, // 2: tests.message.MessageWithMapTypes.accounts:type_name -> tests.message.MessageWithMapTypes.AccountsEntry
+	4, // 3: tests.message.MessageWithMapTypes.names:type_name -> tests.message.MessageWithMapTypes.NamesEntry
+	5, // 4: tests.message.MessageWithMapTypes.AccountsEntry.value:type_name -> tests.message.Account
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_tests_message_message_map_types_proto_init() }
+func file_tests_message_message_map_types_proto_init() {
+	if File_tests_message_message_map_types_proto != nil {
+		return
+	}
+	file_tests_message_message_constructors_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_tests_message_message_map_types_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*MessageWithMapTypes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_message_message_map_types_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_message_message_map_types_proto_goTypes,
+		DependencyIndexes: file_tests_message_message_map_types_proto_depIdxs,
+		MessageInfos:      file_tests_message_message_map_types_proto_msgTypes,
+	}.Build()
+	File_tests_message_message_map_types_proto = out.File
+	file_tests_message_message_map_types_proto_rawDesc = nil
+	file_tests_message_message_map_types_proto_goTypes = nil
+	file_tests_message_message_map_types_proto_depIdxs = nil
+}
+
+// MessageWithMapTypesOption is an option for NewMessageWithMapTypes.
+type MessageWithMapTypesOption func(*MessageWithMapTypes)
+
+// NewMessageWithMapTypes returns a new MessageWithMapTypes with opts applied.
+func NewMessageWithMapTypes(opts ...MessageWithMapTypesOption) *MessageWithMapTypes {
+	x := &MessageWithMapTypes{}
+	for _, opt := range opts {
+		opt(x)
+	}
+	return x
+}
+
+// WithMessageWithMapTypesScores sets the Scores field of a MessageWithMapTypes.
+func WithMessageWithMapTypesScores(v map[UserID]Score) MessageWithMapTypesOption {
+	return func(x *MessageWithMapTypes) {
+		x.Scores = v
+	}
+}
+
+// WithMessageWithMapTypesTotals sets the Totals field of a MessageWithMapTypes.
+func WithMessageWithMapTypesTotals(v Totals) MessageWithMapTypesOption {
+	return func(x *MessageWithMapTypes) {
+		x.Totals = v
+	}
+}
+
+// WithMessageWithMapTypesAccounts sets the Accounts field of a MessageWithMapTypes.
+func WithMessageWithMapTypesAccounts(v map[UserID]*Account) MessageWithMapTypesOption {
+	return func(x *MessageWithMapTypes) {
+		x.Accounts = v
+	}
+}
+
+// WithMessageWithMapTypesNames sets the Names field of a MessageWithMapTypes.
+func WithMessageWithMapTypesNames(v map[int32]String) MessageWithMapTypesOption {
+	return func(x *MessageWithMapTypes) {
+		x.Names = v
+	}
+}
+
+// SetScores sets the Scores field of x.
+func (x *MessageWithMapTypes) SetScores(v map[UserID]Score) {
+	x.Scores = v
+}
+
+// SetTotals sets the Totals field of x.
+func (x *MessageWithMapTypes) SetTotals(v Totals) {
+	x.Totals = v
+}
+
+// SetAccounts sets the Accounts field of x.
+func (x *MessageWithMapTypes) SetAccounts(v map[UserID]*Account) {
+	x.Accounts = v
+}
+
+// SetNames sets the Names field of x.
+func (x *MessageWithMapTypes) SetNames(v map[int32]String) {
+	x.Names = v
+}
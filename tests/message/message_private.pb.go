@@ -0,0 +1,373 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/message/message_private.proto
+
+package message
+
+import (
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Ledger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	revision int64            `protobuf:"varint,2,opt,name=revision,proto3"`
+	urlPath  string           `protobuf:"bytes,3,opt,name=url_path,json=urlPath,proto3"`
+	note     *String          `protobuf:"bytes,4,opt,name=note,proto3,oneof"`
+	entries  []string         `protobuf:"bytes,5,rep,name=entries,proto3"`
+	totals   map[string]int64 `protobuf:"bytes,6,rep,name=totals,proto3" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	parent   *Ledger          `protobuf:"bytes,7,opt,name=parent,proto3"`
+	// Types that are assignable to Kind:
+	//
+	//	*Ledger_Memo
+	Kind isLedger_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *Ledger) Reset() {
+	*x = Ledger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_message_message_private_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ledger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ledger) ProtoMessage() {}
+
+func (x *Ledger) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_message_message_private_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ledger.ProtoReflect.Descriptor instead.
+func (*Ledger) Descriptor() ([]byte, []int) {
+	return file_tests_message_message_private_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ledger) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Ledger) GetRevision() int64 {
+	if x != nil {
+		return x.revision
+	}
+	return 0
+}
+
+func (x *Ledger) GetURLPath() string {
+	if x != nil {
+		return x.urlPath
+	}
+	return ""
+}
+
+func (x *Ledger) GetNote() String {
+	if x != nil && x.note != nil {
+		return *x.note
+	}
+	return ""
+}
+
+func (x *Ledger) GetEntries() []string {
+	if x != nil {
+		return x.entries
+	}
+	return nil
+}
+
+func (x *Ledger) GetTotals() map[string]int64 {
+	if x != nil {
+		return x.totals
+	}
+	return nil
+}
+
+func (x *Ledger) GetParent() *Ledger {
+	if x != nil {
+		return x.parent
+	}
+	return nil
+}
+
+func (m *Ledger) GetKind() isLedger_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *Ledger) GetMemo() string {
+	if x, ok := x.GetKind().(*Ledger_Memo); ok {
+		return x.Memo
+	}
+	return ""
+}
+
+type isLedger_Kind interface {
+	isLedger_Kind()
+}
+
+type Ledger_Memo struct {
+	Memo string `protobuf:"bytes,8,opt,name=memo,proto3,oneof"`
+}
+
+func (*Ledger_Memo) isLedger_Kind() {}
+
+var File_tests_message_message_private_proto protoreflect.FileDescriptor
+
+var file_tests_message_message_private_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x03, 0x0a, 0x06, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xca, 0xb5, 0x03, 0x02, 0x20, 0x01, 0x52, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x08, 0x75, 0x72, 0x6c, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0x0a,
+	0x07, 0x55, 0x52, 0x4c, 0x50, 0x61, 0x74, 0x68, 0x20, 0x01, 0x52, 0x07, 0x75, 0x72, 0x6c, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x1a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20,
+	0x01, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06, 0xca,
+	0xb5, 0x03, 0x02, 0x20, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x41,
+	0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x06, 0xca, 0xb5, 0x03, 0x02, 0x20, 0x01, 0x52, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x73, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x06, 0xca, 0xb5, 0x03, 0x02, 0x20, 0x01,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x1a, 0x39,
+	0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x58,
+	0x01, 0x60, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x6e, 0x6f, 0x74, 0x65, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_message_message_private_proto_rawDescOnce sync.Once
+	file_tests_message_message_private_proto_rawDescData = file_tests_message_message_private_proto_rawDesc
+)
+
+func file_tests_message_message_private_proto_rawDescGZIP() []byte {
+	file_tests_message_message_private_proto_rawDescOnce.Do(func() {
+		file_tests_message_message_private_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_message_message_private_proto_rawDescData)
+	})
+	return file_tests_message_message_private_proto_rawDescData
+}
+
+var file_tests_message_message_private_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tests_message_message_private_proto_goTypes = []any{
+	(*Ledger)(nil), // 0: tests.message.Ledger
+	nil,            // 1: tests.message.Ledger.TotalsEntry
+}
+var file_tests_message_message_private_proto_depIdxs = []int32{
+	1, // 0: tests.message.Ledger.totals:type_name -> tests.message.Ledger.TotalsEntry
+	0, // 1: tests.message.Ledger.parent:type_name -> tests.message.Ledger
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tests_message_message_private_proto_init() }
+func file_tests_message_message_private_proto_init() {
+	if File_tests_message_message_private_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_message_message_private_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Ledger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 4:
+				return &v.revision
+			case 5:
+				return &v.urlPath
+			case 6:
+				return &v.note
+			case 7:
+				return &v.entries
+			case 8:
+				return &v.totals
+			case 9:
+				return &v.parent
+			default:
+				return nil
+			}
+		}
+	}
+	file_tests_message_message_private_proto_msgTypes[0].OneofWrappers = []any{
+		(*Ledger_Memo)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_message_message_private_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_message_message_private_proto_goTypes,
+		DependencyIndexes: file_tests_message_message_private_proto_depIdxs,
+		MessageInfos:      file_tests_message_message_private_proto_msgTypes,
+	}.Build()
+	File_tests_message_message_private_proto = out.File
+	file_tests_message_message_private_proto_rawDesc = nil
+	file_tests_message_message_private_proto_goTypes = nil
+	file_tests_message_message_private_proto_depIdxs = nil
+}
+
+// LedgerOption is an option for NewLedger.
+type LedgerOption func(*Ledger)
+
+// NewLedger returns a new Ledger with opts applied.
+func NewLedger(opts ...LedgerOption) *Ledger {
+	x := &Ledger{}
+	for _, opt := range opts {
+		opt(x)
+	}
+	return x
+}
+
+// WithLedgerName sets the Name field of a Ledger.
+func WithLedgerName(v string) LedgerOption {
+	return func(x *Ledger) {
+		x.Name = v
+	}
+}
+
+// WithLedgerRevision sets the Revision field of a Ledger.
+func WithLedgerRevision(v int64) LedgerOption {
+	return func(x *Ledger) {
+		x.revision = v
+	}
+}
+
+// WithLedgerURLPath sets the URLPath field of a Ledger.
+func WithLedgerURLPath(v string) LedgerOption {
+	return func(x *Ledger) {
+		x.urlPath = v
+	}
+}
+
+// WithLedgerNote sets the Note field of a Ledger.
+func WithLedgerNote(v String) LedgerOption {
+	return func(x *Ledger) {
+		x.note = &v
+	}
+}
+
+// WithLedgerEntries sets the Entries field of a Ledger.
+func WithLedgerEntries(v []string) LedgerOption {
+	return func(x *Ledger) {
+		x.entries = v
+	}
+}
+
+// WithLedgerTotals sets the Totals field of a Ledger.
+func WithLedgerTotals(v map[string]int64) LedgerOption {
+	return func(x *Ledger) {
+		x.totals = v
+	}
+}
+
+// WithLedgerParent sets the Parent field of a Ledger.
+func WithLedgerParent(v *Ledger) LedgerOption {
+	return func(x *Ledger) {
+		x.parent = v
+	}
+}
+
+// WithLedgerMemo sets the Memo field of a Ledger.
+func WithLedgerMemo(v string) LedgerOption {
+	return func(x *Ledger) {
+		x.Kind = &Ledger_Memo{Memo: v}
+	}
+}
+
+// SetName sets the Name field of x.
+func (x *Ledger) SetName(v string) {
+	x.Name = v
+}
+
+// SetRevision sets the Revision field of x.
+func (x *Ledger) SetRevision(v int64) {
+	x.revision = v
+}
+
+// SetURLPath sets the URLPath field of x.
+func (x *Ledger) SetURLPath(v string) {
+	x.urlPath = v
+}
+
+// SetNote sets the Note field of x.
+func (x *Ledger) SetNote(v String) {
+	x.note = &v
+}
+
+// SetEntries sets the Entries field of x.
+func (x *Ledger) SetEntries(v []string) {
+	x.entries = v
+}
+
+// SetTotals sets the Totals field of x.
+func (x *Ledger) SetTotals(v map[string]int64) {
+	x.totals = v
+}
+
+// SetParent sets the Parent field of x.
+func (x *Ledger) SetParent(v *Ledger) {
+	x.parent = v
+}
+
+// SetMemo sets the Memo field of x.
+func (x *Ledger) SetMemo(v string) {
+	x.Kind = &Ledger_Memo{Memo: v}
+}
@@ -0,0 +1,368 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/message/message_setters.proto
+
+package message
+
+import (
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      int64    `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Name    String   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Age     *Int32   `protobuf:"varint,3,opt,name=age,proto3,oneof" json:"age,omitempty"`
+	Tags    []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Account *Account `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	Secret  string   `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Types that are assignable to Contact:
+	//
+	//	*Profile_Phone
+	//	*Profile_Fax
+	Contact isProfile_Contact `protobuf_oneof:"contact"`
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_message_message_setters_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_message_message_setters_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_tests_message_message_setters_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Profile) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Profile) GetName() String {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Profile) GetAge() Int32 {
+	if x != nil && x.Age != nil {
+		return *x.Age
+	}
+	return 0
+}
+
+func (x *Profile) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Profile) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *Profile) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (m *Profile) GetContact() isProfile_Contact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (x *Profile) GetPhone() string {
+	if x, ok := x.GetContact().(*Profile_Phone); ok {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Profile) GetFacsimile() string {
+	if x, ok := x.GetContact().(*Profile_Facsimile); ok {
+		return x.Facsimile
+	}
+	return ""
+}
+
+type isProfile_Contact interface {
+	isProfile_Contact()
+}
+
+type Profile_Phone struct {
+	Phone string `protobuf:"bytes,7,opt,name=phone,proto3,oneof"`
+}
+
+type Profile_Facsimile struct {
+	Facsimile string `protobuf:"bytes,8,opt,name=fax,proto3,oneof"`
+}
+
+func (*Profile_Phone) isProfile_Contact() {}
+
+func (*Profile_Facsimile) isProfile_Contact() {}
+
+type ProfileSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Bio  string `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+}
+
+func (x *ProfileSummary) Reset() {
+	*x = ProfileSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_message_message_setters_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileSummary) ProtoMessage() {}
+
+func (x *ProfileSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_message_message_setters_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileSummary.ProtoReflect.Descriptor instead.
+func (*ProfileSummary) Descriptor() ([]byte, []int) {
+	return file_tests_message_message_setters_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProfileSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProfileSummary) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+var File_tests_message_message_setters_proto protoreflect.FileDescriptor
+
+var file_tests_message_message_setters_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6,
+	0x02, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08,
+	0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x1a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0b, 0xca, 0xb5, 0x03, 0x07, 0x1a, 0x05, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x48,
+	0x01, 0x52, 0x03, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x30, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0xca, 0xb5, 0x03, 0x02, 0x58, 0x00, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x03, 0x66, 0x61, 0x78, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0x0a, 0x09, 0x46, 0x61, 0x63, 0x73,
+	0x69, 0x6d, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x03, 0x66, 0x61, 0x78, 0x3a, 0x06, 0xca, 0xb5,
+	0x03, 0x02, 0x58, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xb5, 0x03, 0x02, 0x58, 0x01, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_message_message_setters_proto_rawDescOnce sync.Once
+	file_tests_message_message_setters_proto_rawDescData = file_tests_message_message_setters_proto_rawDesc
+)
+
+func file_tests_message_message_setters_proto_rawDescGZIP() []byte {
+	file_tests_message_message_setters_proto_rawDescOnce.Do(func() {
+		file_tests_message_message_setters_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_message_message_setters_proto_rawDescData)
+	})
+	return file_tests_message_message_setters_proto_rawDescData
+}
+
+var file_tests_message_message_setters_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tests_message_message_setters_proto_goTypes = []any{
+	(*Profile)(nil),        // 0: tests.message.Profile
+	(*ProfileSummary)(nil), // 1: tests.message.ProfileSummary
+	(*Account)(nil),        // 2: tests.message.Account
+}
+var file_tests_message_message_setters_proto_depIdxs = []int32{
+	2, // 0: tests.message.Profile.account:type_name -> tests.message.Account
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_tests_message_message_setters_proto_init() }
+func file_tests_message_message_setters_proto_init() {
+	if File_tests_message_message_setters_proto != nil {
+		return
+	}
+	file_tests_message_message_constructors_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_tests_message_message_setters_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_message_message_setters_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ProfileSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_tests_message_message_setters_proto_msgTypes[0].OneofWrappers = []any{
+		(*Profile_Phone)(nil),
+		(*Profile_Facsimile)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_message_message_setters_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_message_message_setters_proto_goTypes,
+		DependencyIndexes: file_tests_message_message_setters_proto_depIdxs,
+		MessageInfos:      file_tests_message_message_setters_proto_msgTypes,
+	}.Build()
+	File_tests_message_message_setters_proto = out.File
+	file_tests_message_message_setters_proto_rawDesc = nil
+	file_tests_message_message_setters_proto_goTypes = nil
+	file_tests_message_message_setters_proto_depIdxs = nil
+}
+
+// SetID sets the ID field of x.
+func (x *Profile) SetID(v int64) {
+	x.ID = v
+}
+
+// SetName sets the Name field of x.
+func (x *Profile) SetName(v String) {
+	x.Name = v
+}
+
+// SetAge sets the Age field of x.
+func (x *Profile) SetAge(v Int32) {
+	x.Age = &v
+}
+
+// SetTags sets the Tags field of x.
+func (x *Profile) SetTags(v []string) {
+	x.Tags = v
+}
+
+// SetAccount sets the Account field of x.
+func (x *Profile) SetAccount(v *Account) {
+	x.Account = v
+}
+
+// SetPhone sets the Phone field of x.
+func (x *Profile) SetPhone(v string) {
+	x.Contact = &Profile_Phone{Phone: v}
+}
+
+// SetFacsimile sets the Facsimile field of x.
+func (x *Profile) SetFacsimile(v string) {
+	x.Contact = &Profile_Facsimile{Facsimile: v}
+}
+
+// SetName sets the Name field of x.
+func (x *ProfileSummary) SetName(v string) {
+	x.Name = v
+}
@@ -1,6 +1,9 @@
 package message
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -110,6 +113,33 @@ func TestExtendedMessage(t *testing.T) {
 	_ = proto.GetExtension(m, ExtDelta).(string)
 }
 
+func TestExtensionAccessors(t *testing.T) {
+	m := &ExtendedMessage{}
+	assert.Equal(t, "", GetEpsilon(m))
+	assert.Equal(t, Score(0), GetZeta(m))
+
+	SetEpsilon(m, "epsilon")
+	SetZeta(m, Score(42))
+	SetEta(m, Strings{"a", "b"})
+	SetTheta(m, Tier_TIER_PRO)
+	SetIota(m, &ExtendedMessage{})
+	SetExtLambda(m, "lambda")
+	SetExtensionScope_Mu(m, 7)
+
+	assert.Equal(t, "epsilon", GetEpsilon(m))
+	assert.Equal(t, "epsilon", proto.GetExtension(m, E_Epsilon).(string))
+	assert.Equal(t, Score(42), GetZeta(m))
+	assert.Equal(t, int64(42), proto.GetExtension(m, E_Zeta).(int64))
+	assert.Equal(t, Strings{"a", "b"}, GetEta(m))
+	assert.Equal(t, Tier_TIER_PRO, GetTheta(m))
+	assert.NotNil(t, GetIota(m))
+	assert.Equal(t, "lambda", GetExtLambda(m))
+	assert.Equal(t, int32(7), GetExtensionScope_Mu(m))
+
+	var _ func(*ExtendedMessage) Score = GetZeta
+	var _ func(*ExtendedMessage, Strings) = SetEta
+}
+
 func TestMessageWithOptionals(t *testing.T) {
 	m := &MessageWithOptionals{
 		OptionalString: proto.String("42"),
@@ -233,3 +263,243 @@ func TestMessageWithCustomRepeatedType(t *testing.T) {
 	var _ Strings = m.RepeatedStringField
 	assert.Equal(t, slice, m.RepeatedStringField)
 }
+
+func TestMessageConstructors(t *testing.T) {
+	settings := NewAccount_Settings(WithAccount_SettingsEnabled(true))
+	m := NewAccount(
+		WithAccountID(7),
+		WithAccountName(String("Alice")),
+		WithAccountEmail("alice@example.com"),
+		WithAccountTags([]string{"a", "b"}),
+		WithAccountLabels(map[string]string{"k": "v"}),
+		WithAccountSettings(settings),
+		WithAccountFacsimile("555-1234"),
+	)
+	tests.ValidateMessage(t, m)
+	assert.Equal(t, int64(7), m.ID)
+	assert.Equal(t, String("Alice"), m.Name)
+	assert.Equal(t, "alice@example.com", m.GetEmail())
+	assert.Equal(t, []string{"a", "b"}, m.Tags)
+	assert.Equal(t, map[string]string{"k": "v"}, m.Labels)
+	assert.True(t, m.GetSettings().GetEnabled())
+	assert.Equal(t, "555-1234", m.GetFacsimile())
+	assert.Equal(t, "", m.GetPhone())
+
+	m = NewAccount(WithAccountFacsimile("555-1234"), WithAccountPhone("555-5678"))
+	assert.Equal(t, "555-5678", m.GetPhone())
+	assert.Nil(t, NewAccount().Email)
+}
+
+func TestMessageSetters(t *testing.T) {
+	m := &Profile{}
+	m.SetID(7)
+	m.SetName("Alice")
+	m.SetAge(42)
+	m.SetTags([]string{"a"})
+	m.SetAccount(&Account{ID: 1})
+	m.SetPhone("555-1234")
+	tests.ValidateMessage(t, m)
+	assert.Equal(t, int64(7), m.ID)
+	assert.Equal(t, String("Alice"), m.Name)
+	assert.Equal(t, Int32(42), m.GetAge())
+	assert.Equal(t, []string{"a"}, m.Tags)
+	assert.Equal(t, int64(1), m.GetAccount().GetID())
+	assert.Equal(t, "555-1234", m.GetPhone())
+	m.SetFacsimile("555-5678")
+	assert.Equal(t, "", m.GetPhone())
+	assert.Equal(t, "555-5678", m.GetFacsimile())
+
+	s := &ProfileSummary{}
+	s.SetName("Bob")
+	assert.Equal(t, "Bob", s.Name)
+}
+
+func TestMessageImplements(t *testing.T) {
+	var m any = &Entity{ID: 1, Name: "Alice"}
+	tests.ValidateMessage(t, m.(proto.Message))
+	assert.Implements(t, (*Identifier)(nil), m)
+	assert.Implements(t, (*Namer)(nil), m)
+	m.(Namer).SetName("Bob")
+	assert.Equal(t, String("Bob"), m.(Namer).GetName())
+	assert.Equal(t, int64(1), m.(Identifier).GetID())
+}
+
+func TestMessageWithPrivateFields(t *testing.T) {
+	m := &Ledger{
+		Name:     "ledger",
+		revision: 2,
+		urlPath:  "/ledger",
+		entries:  []string{"a", "b"},
+		totals:   map[string]int64{"a": 1},
+		parent:   &Ledger{Name: "parent"},
+		Kind:     &Ledger_Memo{Memo: "memo"},
+	}
+	note := String("note")
+	m.note = &note
+	tests.ValidateMessage(t, m)
+
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := &Ledger{}
+	if err := proto.Unmarshal(b, got); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, proto.Equal(m, got))
+	assert.Equal(t, int64(2), got.GetRevision())
+	assert.Equal(t, "/ledger", got.GetURLPath())
+	assert.Equal(t, String("note"), got.GetNote())
+	assert.Equal(t, []string{"a", "b"}, got.GetEntries())
+	assert.Equal(t, map[string]int64{"a": 1}, got.GetTotals())
+	assert.Equal(t, "parent", got.GetParent().GetName())
+}
+
+func TestPrivateFieldSettersAndConstructor(t *testing.T) {
+	m := NewLedger(
+		WithLedgerRevision(2),
+		WithLedgerURLPath("/ledger"),
+		WithLedgerNote("note"),
+	)
+	tests.ValidateMessage(t, m)
+	assert.Equal(t, int64(2), m.revision)
+	assert.Equal(t, "/ledger", m.urlPath)
+	assert.Equal(t, String("note"), m.GetNote())
+
+	m.SetRevision(3)
+	m.SetURLPath("/ledgers")
+	m.SetEntries([]string{"a"})
+	assert.Equal(t, int64(3), m.GetRevision())
+	assert.Equal(t, "/ledgers", m.GetURLPath())
+	assert.Equal(t, []string{"a"}, m.entries)
+}
+
+func TestMessageWithMapTypes(t *testing.T) {
+	m := NewMessageWithMapTypes(
+		WithMessageWithMapTypesScores(map[UserID]Score{"alice": 10}),
+		WithMessageWithMapTypesTotals(Totals{"sum": 10}),
+		WithMessageWithMapTypesAccounts(map[UserID]*Account{"alice": {ID: 1}}),
+		WithMessageWithMapTypesNames(map[int32]String{1: "one"}),
+	)
+	tests.ValidateMessage(t, m)
+
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := &MessageWithMapTypes{}
+	if err := proto.Unmarshal(b, got); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[UserID]Score{"alice": 10}, got.GetScores())
+	assert.Equal(t, Totals{"sum": 10}, got.GetTotals())
+	assert.Equal(t, int64(1), got.GetAccounts()["alice"].GetID())
+	assert.Equal(t, map[int32]String{1: "one"}, got.GetNames())
+}
+
+func TestMessageWithCustomElemType(t *testing.T) {
+	m := &MessageWithCustomElemType{
+		UserIds: []UserID{"alice", "bob"},
+		Scores:  []Score{1, 2},
+	}
+	tests.ValidateMessage(t, m)
+	var _ []UserID = m.GetUserIds()
+	var _ []Score = m.GetScores()
+
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := &MessageWithCustomElemType{}
+	if err := proto.Unmarshal(b, got); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, m.UserIds, got.UserIds)
+	assert.Equal(t, m.Scores, got.Scores)
+}
+
+func TestMessageWithCustomEnumTypes(t *testing.T) {
+	m := &MessageWithCustomEnumTypes{
+		Color:  Shade(Color_COLOR_RED),
+		Colors: Shades{Color_COLOR_RED, Color_COLOR_GREEN},
+		Choice: &MessageWithCustomEnumTypes_OneofColor{OneofColor: Shade(Color_COLOR_GREEN)},
+	}
+	green := Shade(Color_COLOR_GREEN)
+	m.OptionalColor = &green
+	tests.ValidateMessage(t, m)
+	assert.True(t, m.GetColor().IsPrimary())
+	assert.False(t, m.GetOptionalColor().IsPrimary())
+	assert.Equal(t, Shades{Color_COLOR_RED, Color_COLOR_GREEN}, m.GetColors())
+	assert.Equal(t, Shade(Color_COLOR_GREEN), m.GetOneofColor())
+
+	var empty *MessageWithCustomEnumTypes
+	assert.Equal(t, Shade(Color_COLOR_UNSPECIFIED), empty.GetColor())
+	assert.Equal(t, Shade(Color_COLOR_UNSPECIFIED), empty.GetOptionalColor())
+	assert.Equal(t, Shade(Color_COLOR_UNSPECIFIED), empty.GetOneofColor())
+
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := &MessageWithCustomEnumTypes{}
+	if err := proto.Unmarshal(b, got); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, proto.Equal(m, got))
+}
+
+func TestDocComments(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "message_docs.pb.go", nil, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	docs := make(map[string]string)
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.GenDecl:
+			if len(n.Specs) == 1 {
+				if ts, ok := n.Specs[0].(*ast.TypeSpec); ok {
+					docs[ts.Name.Name] = n.Doc.Text()
+				}
+			}
+		case *ast.TypeSpec:
+			if st, ok := n.Type.(*ast.StructType); ok {
+				for _, field := range st.Fields.List {
+					for _, name := range field.Names {
+						docs[n.Name.Name+"."+name.Name] = field.Doc.Text()
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			docs[n.Names[0].Name] = n.Doc.Text()
+		case *ast.FuncDecl:
+			if n.Recv != nil {
+				docs[n.Name.Name] = n.Doc.Text()
+			}
+		}
+		return true
+	})
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Document", "Document is a message with a doc comment replaced by an option.\n"},
+		{"Document.Title", "Title is the title of the document.\n\nIt is never empty.\n"},
+		{"Document.Body", "Body has a leading comment.\n\nThe body is formatted with Markdown.\n"},
+		{"Document.Summary", "Summary has a leading comment.\n\nDeprecated: Use Body instead.\n"},
+		{"Document.Subtitle", "Subtitle has a doc comment without a leading comment.\n"},
+		{"Document.Author", ""},
+		{"Document_Url.Url", "URL has a leading comment.\n\nThe URL is absolute.\n"},
+		{"Document_Path.Path", "Deprecated: Use Url instead.\n"},
+		{"GetSummary", "Deprecated: Use Body instead.\n"},
+		{"GetPath", "Deprecated: Use Url instead.\n"},
+		{"LegacyDocument", "LegacyDocument is a deprecated message.\n\nDeprecated: Use Document instead.\n"},
+		{"DocumentKind", "DocumentKind has a leading comment.\n\nThe zero value is unspecified.\n"},
+		{"DocumentKind_DOCUMENT_KIND_UNSPECIFIED", "DocumentKind_DOCUMENT_KIND_UNSPECIFIED is the zero value.\n"},
+		{"DocumentKind_DOCUMENT_KIND_MEMO", "Deprecated: Use DOCUMENT_KIND_NOTE instead.\n"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, docs[tt.name], tt.name)
+	}
+}
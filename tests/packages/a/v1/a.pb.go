@@ -0,0 +1,149 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: tests/packages/a/v1/a.proto
+
+package v1
+
+import (
+	_ "github.com/alta/protopatch/patch/gopb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AThing should be renamed to AThing, in Go package v1.
+type AThing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AThing) Reset() {
+	*x = AThing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_packages_a_v1_a_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AThing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AThing) ProtoMessage() {}
+
+func (x *AThing) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_packages_a_v1_a_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Thing.ProtoReflect.Descriptor instead.
+func (*AThing) Descriptor() ([]byte, []int) {
+	return file_tests_packages_a_v1_a_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AThing) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+var File_tests_packages_a_v1_a_proto protoreflect.FileDescriptor
+
+var file_tests_packages_a_v1_a_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2f, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x61, 0x2e,
+	0x76, 0x31, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x2f, 0x0a, 0x05, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x41, 0x54, 0x68,
+	0x69, 0x6e, 0x67, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2f, 0x61, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_packages_a_v1_a_proto_rawDescOnce sync.Once
+	file_tests_packages_a_v1_a_proto_rawDescData = file_tests_packages_a_v1_a_proto_rawDesc
+)
+
+func file_tests_packages_a_v1_a_proto_rawDescGZIP() []byte {
+	file_tests_packages_a_v1_a_proto_rawDescOnce.Do(func() {
+		file_tests_packages_a_v1_a_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_packages_a_v1_a_proto_rawDescData)
+	})
+	return file_tests_packages_a_v1_a_proto_rawDescData
+}
+
+var file_tests_packages_a_v1_a_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_tests_packages_a_v1_a_proto_goTypes = []any{
+	(*AThing)(nil), // 0: tests.packages.a.v1.Thing
+}
+var file_tests_packages_a_v1_a_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_tests_packages_a_v1_a_proto_init() }
+func file_tests_packages_a_v1_a_proto_init() {
+	if File_tests_packages_a_v1_a_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_packages_a_v1_a_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*AThing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_packages_a_v1_a_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_packages_a_v1_a_proto_goTypes,
+		DependencyIndexes: file_tests_packages_a_v1_a_proto_depIdxs,
+		MessageInfos:      file_tests_packages_a_v1_a_proto_msgTypes,
+	}.Build()
+	File_tests_packages_a_v1_a_proto = out.File
+	file_tests_packages_a_v1_a_proto_rawDesc = nil
+	file_tests_packages_a_v1_a_proto_goTypes = nil
+	file_tests_packages_a_v1_a_proto_depIdxs = nil
+}
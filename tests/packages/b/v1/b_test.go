@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/alta/protopatch/tests"
+	av1 "github.com/alta/protopatch/tests/packages/a/v1"
+)
+
+func TestDuplicatePackageNames(t *testing.T) {
+	m := &BThing{ID: "b", A: &av1.AThing{ID: "a"}}
+	tests.ValidateMessage(t, m)
+	var _ *av1.AThing = m.GetA()
+	if got, want := m.GetA().ID, "a"; got != want {
+		t.Errorf("m.GetA().ID = %q, want %q", got, want)
+	}
+}